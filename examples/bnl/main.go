@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 
-	"github.com/gkoos/skyline/skyline"
+	"github.com/gkoos/skyline/v2/skyline"
 )
 
 func main() {
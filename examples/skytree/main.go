@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 
-	"github.com/gkoos/skyline/skyline"
+	"github.com/gkoos/skyline/v2/skyline"
 )
 
 func main() {
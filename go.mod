@@ -1,3 +1,3 @@
-module github.com/gkoos/skyline
+module github.com/gkoos/skyline/v2
 
 go 1.23.3
@@ -3,7 +3,7 @@ package algorithms
 import (
 	"testing"
 
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 func BenchmarkBNL_10000SmallSkyline4D(b *testing.B) {
@@ -187,3 +187,30 @@ func BenchmarkSkyTree_200000ClusteredSmallSkyline8D(b *testing.B) {
 		SkyTree(Dataset200000ClusteredSmallSkyline8D, prefs, DefaultSkyTreeConfig)
 	}
 }
+
+func BenchmarkBNLMatrix_10000SmallSkyline4D(b *testing.B) {
+	prefs := types.Preference{types.Min, types.Max, types.Min, types.Max}
+	m := types.MatrixFromDataset(Dataset10000SmallSkyline4D)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BNLMatrix(m, prefs, BNLConfig{})
+	}
+}
+
+func BenchmarkDNCMatrix_100000SmallSkyline4D(b *testing.B) {
+	prefs := types.Preference{types.Min, types.Max, types.Min, types.Max}
+	m := types.MatrixFromDataset(Dataset100000SmallSkyline4D)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DivideAndConquerMatrix(m, prefs, nil)
+	}
+}
+
+func BenchmarkSkyTreeMatrix_200000ClusteredSmallSkyline8D(b *testing.B) {
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min}
+	m := types.MatrixFromDataset(Dataset200000ClusteredSmallSkyline8D)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SkyTreeMatrix(m, prefs, DefaultSkyTreeConfig)
+	}
+}
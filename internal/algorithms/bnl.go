@@ -1,26 +1,32 @@
 package algorithms
 
 import (
-	"github.com/gkoos/skyline/internal/utilities"
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 type BNLConfig = types.BNLConfig
 
 // BlockNestedLoop is a re-export for compatibility with static.go and tests.
-func BlockNestedLoop(data []types.Point, prefs types.Preference) []types.Point {
+func BlockNestedLoop[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference) S {
 	return BNL(data, prefs, BNLConfig{Epsilon: 0})
 }
 
-func BNL(data []types.Point, prefs types.Preference, cfg BNLConfig) []types.Point {
-	var skyline []types.Point
+// BNL computes the skyline using Block Nested Loop. For integer coordinates cfg.Epsilon is truncated.
+// Points outside cfg.Constraints are skipped before they can enter the window.
+func BNL[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, cfg BNLConfig) S {
+	epsilon := T(cfg.Epsilon)
+	var skyline S
 	for _, p := range data {
+		if !utilities.Satisfies(p, cfg.Constraints) {
+			continue
+		}
 		dominated := false
 		for i := 0; i < len(skyline); {
-			if utilities.DominatesEpsilon(skyline[i], p, prefs, cfg.Epsilon) {
+			if utilities.DominatesEpsilon(skyline[i], p, prefs, epsilon) {
 				dominated = true
 				break
-			} else if utilities.DominatesEpsilon(p, skyline[i], prefs, cfg.Epsilon) {
+			} else if utilities.DominatesEpsilon(p, skyline[i], prefs, epsilon) {
 				skyline = append(skyline[:i], skyline[i+1:]...)
 			} else {
 				i++
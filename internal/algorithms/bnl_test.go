@@ -3,7 +3,7 @@ package algorithms
 import (
 	"testing"
 
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 func TestBNL_Skyline(t *testing.T) {
@@ -0,0 +1,47 @@
+package algorithms
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestConstrainedSkyline(t *testing.T) {
+	// price (Min), weight (Min), rating (Max)
+	data := make(types.Dataset, 0, 3000)
+	for i := 0; i < 3000; i++ {
+		data = append(data, types.Point{float64(100 + (i*37)%900), float64(1+(i*13)%30) / 10, float64((i * 7) % 50)})
+	}
+	// A cheap, light, top-rated product outside the price range must not prune anything
+	data = append(data, types.Point{50, 0.5, 100})
+	prefs := types.Preference{types.Min, types.Min, types.Max}
+	constraints := types.Constraints{{Min: 200, Max: 800}, {Min: math.Inf(-1), Max: 2}}
+
+	var filtered types.Dataset
+	for _, p := range data {
+		if utilities.Satisfies(p, constraints) {
+			filtered = append(filtered, p)
+		}
+	}
+	expected := BlockNestedLoop(filtered, prefs)
+
+	skytreeCfg := DefaultSkyTreeConfig
+	skytreeCfg.BNLSwitchThreshold = 32
+	skytreeCfg.Constraints = constraints
+	m := types.MatrixFromDataset(data)
+	results := map[string]types.Dataset{
+		"bnl":            BNL(data, prefs, BNLConfig{Constraints: constraints}),
+		"dnc":            DivideAndConquer(append(types.Dataset(nil), data...), prefs, &types.DNCConfig{Threshold: 50, BatchSize: 50, Constraints: constraints}),
+		"skytree":        SkyTree(data, prefs, skytreeCfg),
+		"bnl-matrix":     m.Select(BNLMatrix(m, prefs, BNLConfig{Constraints: constraints})).Dataset(),
+		"dnc-matrix":     m.Select(DivideAndConquerMatrix(m, prefs, &types.DNCConfig{Threshold: 50, BatchSize: 50, Constraints: constraints})).Dataset(),
+		"skytree-matrix": m.Select(SkyTreeMatrix(m, prefs, skytreeCfg)).Dataset(),
+	}
+	for algo, result := range results {
+		if !equalSkylineSet(result, expected) {
+			t.Errorf("%s constrained skyline incorrect: got %d points, want %d", algo, len(result), len(expected))
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package algorithms
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// CrowdingDistance computes the NSGA-II crowding distance of every point in a front.
+// For each non-ignored dimension the points are sorted, the two boundary points get an infinite
+// distance and every other point adds the normalized gap between its two neighbors.
+func CrowdingDistance[S ~[]types.PointOf[T], T types.Number](front S, prefs types.Preference) []float64 {
+	n := len(front)
+	dist := make([]float64, n)
+	if n <= 2 {
+		for i := range dist {
+			dist[i] = math.Inf(1)
+		}
+		return dist
+	}
+	idx := make([]int, n)
+	for dim, order := range prefs {
+		if order == types.Ignore {
+			continue
+		}
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.Slice(idx, func(i, j int) bool { return front[idx[i]][dim] < front[idx[j]][dim] })
+		lo, hi := float64(front[idx[0]][dim]), float64(front[idx[n-1]][dim])
+		dist[idx[0]] = math.Inf(1)
+		dist[idx[n-1]] = math.Inf(1)
+		if hi == lo {
+			continue
+		}
+		for i := 1; i < n-1; i++ {
+			dist[idx[i]] += (float64(front[idx[i+1]][dim]) - float64(front[idx[i-1]][dim])) / (hi - lo)
+		}
+	}
+	return dist
+}
+
+// SelectSurvivors picks n points NSGA-II style: whole Pareto fronts are taken in rank order
+// while they fit, and the front that overflows is truncated by decreasing crowding distance.
+// It returns the indices of the selected points in data.
+func SelectSurvivors[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, n int) []int {
+	if n <= 0 {
+		return nil
+	}
+	if n >= len(data) {
+		return allIndices(len(data))
+	}
+	var fronts [][]int
+	for i, rank := range LayerRanks(data, prefs, 0) {
+		for len(fronts) < rank {
+			fronts = append(fronts, nil)
+		}
+		fronts[rank-1] = append(fronts[rank-1], i)
+	}
+
+	survivors := make([]int, 0, n)
+	for _, front := range fronts {
+		if len(survivors)+len(front) <= n {
+			survivors = append(survivors, front...)
+			continue
+		}
+		points := make(S, len(front))
+		for i, idx := range front {
+			points[i] = data[idx]
+		}
+		dist := CrowdingDistance(points, prefs)
+		order := allIndices(len(front))
+		sort.SliceStable(order, func(i, j int) bool { return dist[order[i]] > dist[order[j]] })
+		for _, o := range order[:n-len(survivors)] {
+			survivors = append(survivors, front[o])
+		}
+		break
+	}
+	return survivors
+}
+
+func allIndices(n int) []int {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	return idx
+}
@@ -0,0 +1,65 @@
+package algorithms
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestCrowdingDistance(t *testing.T) {
+	front := types.Dataset{{0, 4}, {1, 3}, {3, 1}, {4, 0}}
+	prefs := types.Preference{types.Min, types.Min}
+	dist := CrowdingDistance(front, prefs)
+	if !math.IsInf(dist[0], 1) || !math.IsInf(dist[3], 1) {
+		t.Errorf("boundary points should have infinite distance, got %v", dist)
+	}
+	// (3-0)/4 in each dimension
+	if math.Abs(dist[1]-1.5) > 1e-9 || math.Abs(dist[2]-1.5) > 1e-9 {
+		t.Errorf("expected interior distances of 1.5, got %v", dist)
+	}
+	if d := CrowdingDistance(types.Dataset{{1, 1}, {2, 2}}, prefs); !math.IsInf(d[0], 1) || !math.IsInf(d[1], 1) {
+		t.Errorf("fronts of two points should be all infinite, got %v", d)
+	}
+}
+
+func TestSelectSurvivors(t *testing.T) {
+	data := types.Dataset{
+		{0, 10}, {1, 6}, {2, 5}, {5, 2}, {10, 0}, // front 1
+		{1, 10}, {3, 6}, {4, 5}, {6, 3}, {11, 1}, // front 2
+		{12, 12}, // front 3
+	}
+	prefs := types.Preference{types.Min, types.Min}
+
+	got := SelectSurvivors(data, prefs, 5)
+	if !equalIndexSet(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("expected exactly the first front, got %v", got)
+	}
+
+	// First front plus the two boundary points and the least crowded interior point of the second
+	got = SelectSurvivors(data, prefs, 8)
+	if !equalIndexSet(got, []int{0, 1, 2, 3, 4, 5, 9, 8}) {
+		t.Errorf("unexpected survivors %v", got)
+	}
+
+	if len(SelectSurvivors(data, prefs, 20)) != len(data) {
+		t.Errorf("asking for more survivors than points should return all of them")
+	}
+}
+
+func equalIndexSet(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int]int)
+	for _, v := range a {
+		seen[v]++
+	}
+	for _, v := range b {
+		if seen[v] == 0 {
+			return false
+		}
+		seen[v]--
+	}
+	return true
+}
@@ -6,25 +6,27 @@ import (
 	"sort"
 	"sync"
 
-	"github.com/gkoos/skyline/internal/utilities"
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 var defaultDNCConfig = types.DNCConfig{Threshold: 100, BatchSize: 100}
 
-func DivideAndConquer(data types.Dataset, prefs types.Preference, cfg *types.DNCConfig) types.Dataset {
+// DivideAndConquer computes the skyline by recursively splitting on the dimension with the
+// largest range. For integer coordinates cfg.Epsilon is truncated.
+func DivideAndConquer[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, cfg *types.DNCConfig) S {
 	if cfg == nil {
 		cfg = &defaultDNCConfig
 	}
 
 	// Apply BNL if small enough
 	if len(data) <= cfg.Threshold {
-		return BlockNestedLoop(data, prefs)
+		return BNL(data, prefs, BNLConfig{Constraints: cfg.Constraints})
 	}
 
 	// Find dimension with largest range
 	numDimensions := len(data[0])
-	maxRange := 0.0
+	var maxRange T
 	splitDim := 0
 	for d := range numDimensions {
 		minVal, maxVal := data[0][d], data[0][d]
@@ -50,9 +52,13 @@ func DivideAndConquer(data types.Dataset, prefs types.Preference, cfg *types.DNC
 	medianIdx := len(data) / 2
 	median := data[medianIdx][splitDim]
 
-	// Partition points with random assignment for values equal to median
-	var left, right types.Dataset
+	// Partition points with random assignment for values equal to median,
+	// dropping points outside the constraints so they never take part in a merge
+	var left, right S
 	for _, p := range data {
+		if !utilities.Satisfies(p, cfg.Constraints) {
+			continue
+		}
 		if p[splitDim] < median {
 			left = append(left, p)
 		} else if p[splitDim] > median {
@@ -68,7 +74,7 @@ func DivideAndConquer(data types.Dataset, prefs types.Preference, cfg *types.DNC
 	}
 
 	// Parallelize recursive calls
-	var leftSkyline, rightSkyline types.Dataset
+	var leftSkyline, rightSkyline S
 	var wg sync.WaitGroup
 	wg.Add(2)
 	go func() {
@@ -82,14 +88,15 @@ func DivideAndConquer(data types.Dataset, prefs types.Preference, cfg *types.DNC
 	wg.Wait()
 
 	// Batch merge using cfg.BatchSize (symmetric merge)
-	merged := make(types.Dataset, 0, len(leftSkyline)+len(rightSkyline))
-	merged = appendNonDominated(merged, leftSkyline, rightSkyline, prefs, cfg.BatchSize, cfg.Epsilon)
-	merged = appendNonDominated(merged, rightSkyline, leftSkyline, prefs, cfg.BatchSize, cfg.Epsilon)
+	epsilon := T(cfg.Epsilon)
+	merged := make(S, 0, len(leftSkyline)+len(rightSkyline))
+	merged = appendNonDominated(merged, leftSkyline, rightSkyline, prefs, cfg.BatchSize, epsilon)
+	merged = appendNonDominated(merged, rightSkyline, leftSkyline, prefs, cfg.BatchSize, epsilon)
 
 	return merged
 }
 
-func appendNonDominated(merged types.Dataset, src, other types.Dataset, prefs types.Preference, batchSize int, epsilon float64) types.Dataset {
+func appendNonDominated[S ~[]types.PointOf[T], T types.Number](merged S, src, other S, prefs types.Preference, batchSize int, epsilon T) S {
 	for i := 0; i < len(src); i += batchSize {
 		end := i + batchSize
 		if end > len(src) {
@@ -0,0 +1,31 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// Eclipse returns the points of data that are not eclipse-dominated under ratios
+// (see utilities.EclipseDominates).
+//
+// Eclipse dominance is ordinary dominance between the vectors of corner scores, so every point is
+// mapped once to its 2^len(ratios) scores and the skyline of those vectors is computed with a
+// presorted BNL over a score matrix.
+func Eclipse(data []types.Point, prefs types.Preference, ratios []types.WeightRatio) []types.Point {
+	if len(data) == 0 {
+		return nil
+	}
+	scores := make([]types.Point, len(data))
+	for i, p := range data {
+		scores[i] = utilities.EclipseScores(p, prefs, ratios)
+	}
+	scorePrefs := make(types.Preference, len(scores[0]))
+	m := types.MatrixFromPoints(scores)
+
+	sky := bnlIndices(m, monotoneOrder(scores, scorePrefs), scorePrefs, 0, nil)
+	result := make([]types.Point, len(sky))
+	for i, idx := range sky {
+		result[i] = data[idx]
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestEclipseMatchesPairwise(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	data := make(types.Dataset, 400)
+	for i := range data {
+		data[i] = types.Point{rng.Float64() * 100, rng.Float64() * 10, float64(rng.Intn(5)), rng.Float64()}
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Ignore, types.Min}
+	ratios := []types.WeightRatio{{Low: 0.1, High: 0.5}, {Low: 1, High: 3}}
+
+	var expected types.Dataset
+	for _, p := range data {
+		eclipsed := false
+		for _, q := range data {
+			if utilities.EclipseDominates(q, p, prefs, ratios) {
+				eclipsed = true
+				break
+			}
+		}
+		if !eclipsed {
+			expected = append(expected, p)
+		}
+	}
+	got := Eclipse(data, prefs, ratios)
+	if !equalSkylineSet(got, expected) {
+		t.Errorf("expected %d eclipse points, got %d", len(expected), len(got))
+	}
+
+	// The eclipse set lies between the top-1 point and the skyline
+	sky := BNL(data, prefs, BNLConfig{})
+	if len(got) == 0 || len(got) > len(sky) {
+		t.Errorf("expected between 1 and %d eclipse points, got %d", len(sky), len(got))
+	}
+}
+
+func TestEclipseFixedWeights(t *testing.T) {
+	// Low == High leaves a single weighting, so only the best-scoring points survive
+	data := []types.Point{{1, 5}, {2, 2}, {4, 1}, {3, 3}}
+	prefs := types.Preference{types.Min, types.Min}
+	got := Eclipse(data, prefs, []types.WeightRatio{{Low: 1, High: 1}})
+	if !equalSkylineSet(got, types.Dataset{{2, 2}}) {
+		t.Errorf("expected only {2, 2}, got %v", got)
+	}
+}
@@ -0,0 +1,68 @@
+package algorithms
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// SkylineFrequency counts, for each of n points, the number of cuboids of a skycube that contain it.
+func SkylineFrequency(cube map[types.Subspace][]int32, n int) []int {
+	freq := make([]int, n)
+	for _, idx := range cube {
+		for _, i := range idx {
+			freq[i]++
+		}
+	}
+	return freq
+}
+
+// MinimalSubspaces returns the subspaces in which point i is a skyline point but in none of
+// their proper subspaces, sorted by size and then by bitmask. Because of ties, membership is
+// not monotone in the subspace, so minimality is checked against all member subspaces rather
+// than only immediate children.
+func MinimalSubspaces(cube map[types.Subspace][]int32, i int) []types.Subspace {
+	var member []types.Subspace
+	for s, idx := range cube {
+		if containsIndex(idx, int32(i)) {
+			member = append(member, s)
+		}
+	}
+	sort.Slice(member, func(a, b int) bool {
+		return subspaceLess(member[a], member[b])
+	})
+
+	var minimal []types.Subspace
+	for _, s := range member {
+		covered := false
+		for _, m := range minimal {
+			if m&s == m {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			minimal = append(minimal, s)
+		}
+	}
+	return minimal
+}
+
+// subspaceLess orders subspaces by number of dimensions, then by bitmask.
+func subspaceLess(a, b types.Subspace) bool {
+	na, nb := bits.OnesCount64(uint64(a)), bits.OnesCount64(uint64(b))
+	if na != nb {
+		return na < nb
+	}
+	return a < b
+}
+
+func containsIndex(idx []int32, i int32) bool {
+	for _, j := range idx {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
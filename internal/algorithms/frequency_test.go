@@ -0,0 +1,44 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestSkylineFrequency(t *testing.T) {
+	// {1,5,5} wins dimension 0, {5,1,5} dimension 1, {5,5,1} dimension 2, {2,2,2} only combinations
+	data := types.Dataset{{1, 5, 5}, {5, 1, 5}, {5, 5, 1}, {2, 2, 2}, {6, 6, 6}}
+	prefs := types.Preference{types.Min, types.Min, types.Min}
+	cube, err := SkyCube(data, prefs)
+	if err != nil {
+		t.Fatalf("SkyCube failed: %v", err)
+	}
+
+	// {1,5,5}: {0}, {0,1}, {0,2}, {0,1,2}; {2,2,2}: {0,1}, {0,2}, {1,2}, {0,1,2}
+	freq := SkylineFrequency(cube, len(data))
+	want := []int{4, 4, 4, 4, 0}
+	for i := range want {
+		if freq[i] != want[i] {
+			t.Errorf("point %v: expected frequency %d, got %d", data[i], want[i], freq[i])
+		}
+	}
+
+	minimal := MinimalSubspaces(cube, 0)
+	if len(minimal) != 1 || minimal[0] != types.SubspaceOf(0) {
+		t.Errorf("expected {1,5,5} to be minimal in dimension 0 only, got %v", minimal)
+	}
+	minimal = MinimalSubspaces(cube, 3)
+	expected := []types.Subspace{types.SubspaceOf(0, 1), types.SubspaceOf(0, 2), types.SubspaceOf(1, 2)}
+	if len(minimal) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, minimal)
+	}
+	for i := range expected {
+		if minimal[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, minimal)
+		}
+	}
+	if len(MinimalSubspaces(cube, 4)) != 0 {
+		t.Errorf("a dominated point has no skyline subspaces")
+	}
+}
@@ -0,0 +1,66 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// equalSkylineSetOf compares two point sets of any coordinate type (order-insensitive)
+func equalSkylineSetOf[T types.Number](a, b []types.PointOf[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+	for _, pa := range a {
+		found := false
+		for j, pb := range b {
+			if !matched[j] && isPointEqual(pa, pb) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGeneric_Int32Skyline(t *testing.T) {
+	// price in cents (Min), rating count (Max)
+	data := make([]types.PointOf[int32], 0, 3000)
+	expected := []types.PointOf[int32]{{9900, 10}, {14900, 50}, {19900, 90}}
+	data = append(data, expected...)
+	for i := int32(0); i < 2997; i++ {
+		base := expected[i%3]
+		data = append(data, types.PointOf[int32]{base[0] + 1 + i%100, base[1] - 1 - i%5})
+	}
+	prefs := types.Preference{types.Min, types.Max}
+	cfg := DefaultSkyTreeConfig
+	cfg.BNLSwitchThreshold = 16
+
+	results := map[string][]types.PointOf[int32]{
+		"bnl":            BlockNestedLoop(data, prefs),
+		"dnc":            DivideAndConquer(append([]types.PointOf[int32](nil), data...), prefs, nil),
+		"skytree":        SkyTree(data, prefs, cfg),
+		"bnl-matrix":     types.MatrixFromPoints(data).Select(BNLMatrix(types.MatrixFromPoints(data), prefs, BNLConfig{})).Dataset(),
+		"skytree-matrix": types.MatrixFromPoints(data).Select(SkyTreeMatrix(types.MatrixFromPoints(data), prefs, cfg)).Dataset(),
+	}
+	for algo, result := range results {
+		if !equalSkylineSetOf(result, expected) {
+			t.Errorf("%s int32 skyline incorrect: got %v, want %v", algo, result, expected)
+		}
+	}
+}
+
+func TestGeneric_Float32Skyline(t *testing.T) {
+	data := []types.PointOf[float32]{{0.1, 0.9}, {0.2, 0.8}, {0.3, 0.95}, {0.05, 0.5}}
+	expected := []types.PointOf[float32]{{0.3, 0.95}, {0.05, 0.5}, {0.1, 0.9}}
+	prefs := types.Preference{types.Min, types.Max}
+	result := BNL(data, prefs, BNLConfig{})
+	if !equalSkylineSetOf(result, expected) {
+		t.Errorf("float32 skyline incorrect: got %v, want %v", result, expected)
+	}
+}
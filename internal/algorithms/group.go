@@ -0,0 +1,103 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// GroupSkyline returns the groups of k points whose aggregates are not dominated by the aggregate of
+// any other group of k points. aggs[dim] aggregates dimension dim and prefs applies to the aggregates.
+//
+// SUM, MIN and MAX are monotone, so swapping a member for a point dominating it never worsens the
+// group. Every group is therefore matched or beaten by a group that is closed under dominance, one
+// containing all dominators of its members. Members of a closed group have fewer than k dominators,
+// so only the k-skyband, which lies within the first k skyline layers, is enumerated, and only
+// closed groups are built. With SUM the swap is a strict improvement and the result is exact; with
+// MIN or MAX, groups that tie exactly with a returned group on every aggregate may be omitted.
+func GroupSkyline(data []types.Point, prefs types.Preference, aggs []types.Aggregate, k int) []types.Group {
+	if k <= 0 || k > len(data) {
+		return nil
+	}
+	band, _ := kSkybandIndices(data, prefs, k)
+
+	// Dominators of a band point are in the band and precede it in monotone order
+	dominators := make([][]int, len(band))
+	for i := range band {
+		for j := 0; j < i; j++ {
+			if utilities.DominatesEpsilon(data[band[j]], data[band[i]], prefs, 0) {
+				dominators[i] = append(dominators[i], j)
+			}
+		}
+	}
+
+	var window []types.Group
+	chosen := make([]bool, len(band))
+	members := make([]int, 0, k)
+	var enumerate func(i int)
+	enumerate = func(i int) {
+		if len(members) == k {
+			window = insertGroup(window, newGroup(data, band, members, aggs), prefs)
+			return
+		}
+		if len(band)-i < k-len(members) {
+			return
+		}
+		if allChosen(chosen, dominators[i]) {
+			chosen[i] = true
+			members = append(members, i)
+			enumerate(i + 1)
+			members = members[:len(members)-1]
+			chosen[i] = false
+		}
+		enumerate(i + 1)
+	}
+	enumerate(0)
+	return window
+}
+
+func allChosen(chosen []bool, idx []int) bool {
+	for _, j := range idx {
+		if !chosen[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// newGroup aggregates the band points at the given positions.
+func newGroup(data []types.Point, band, members []int, aggs []types.Aggregate) types.Group {
+	g := types.Group{Points: make([]types.Point, len(members))}
+	for i, m := range members {
+		g.Points[i] = data[band[m]]
+	}
+	g.Aggregate = append(types.Point(nil), g.Points[0]...)
+	for _, p := range g.Points[1:] {
+		for dim, agg := range aggs {
+			switch agg {
+			case types.SumAggregate:
+				g.Aggregate[dim] += p[dim]
+			case types.MinAggregate:
+				g.Aggregate[dim] = min(g.Aggregate[dim], p[dim])
+			case types.MaxAggregate:
+				g.Aggregate[dim] = max(g.Aggregate[dim], p[dim])
+			}
+		}
+	}
+	return g
+}
+
+// insertGroup adds g to a BNL window of groups unless its aggregate is dominated.
+func insertGroup(window []types.Group, g types.Group, prefs types.Preference) []types.Group {
+	for _, w := range window {
+		if utilities.DominatesEpsilon(w.Aggregate, g.Aggregate, prefs, 0) {
+			return window
+		}
+	}
+	kept := window[:0]
+	for _, w := range window {
+		if !utilities.DominatesEpsilon(g.Aggregate, w.Aggregate, prefs, 0) {
+			kept = append(kept, w)
+		}
+	}
+	return append(kept, g)
+}
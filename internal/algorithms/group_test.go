@@ -0,0 +1,101 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bruteForceGroupSkyline aggregates every k-subset and keeps the non-dominated aggregates
+func bruteForceGroupSkyline(data []types.Point, prefs types.Preference, aggs []types.Aggregate, k int) types.Dataset {
+	var all types.Dataset
+	band := allIndices(len(data))
+	members := make([]int, 0, k)
+	var rec func(i int)
+	rec = func(i int) {
+		if len(members) == k {
+			all = append(all, newGroup(data, band, members, aggs).Aggregate)
+			return
+		}
+		for j := i; j < len(data); j++ {
+			members = append(members, j)
+			rec(j + 1)
+			members = members[:len(members)-1]
+		}
+	}
+	rec(0)
+	return BNL(all, prefs, BNLConfig{})
+}
+
+func aggregatesOf(groups []types.Group) types.Dataset {
+	var out types.Dataset
+	for _, g := range groups {
+		out = append(out, g.Aggregate)
+	}
+	return out
+}
+
+// distinct removes duplicate points
+func distinct(data types.Dataset) types.Dataset {
+	var out types.Dataset
+	for _, p := range data {
+		if !containsPoint(out, p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func containsPoint(data types.Dataset, p types.Point) bool {
+	for _, q := range data {
+		if isPointEqual(q, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGroupSkylineSum(t *testing.T) {
+	rng := rand.New(rand.NewSource(21))
+	data := make([]types.Point, 14)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(10)), float64(rng.Intn(10))}
+	}
+	prefs := types.Preference{types.Min, types.Max}
+	aggs := []types.Aggregate{types.SumAggregate, types.SumAggregate}
+	for k := 1; k <= 4; k++ {
+		expected := bruteForceGroupSkyline(data, prefs, aggs, k)
+		// With SUM every non-closed group is strictly beaten, so nothing is omitted
+		got := aggregatesOf(GroupSkyline(data, prefs, aggs, k))
+		if !equalSkylineSet(got, expected) {
+			t.Errorf("k=%d: expected aggregates %v, got %v", k, expected, got)
+		}
+	}
+}
+
+func TestGroupSkylineMinMax(t *testing.T) {
+	rng := rand.New(rand.NewSource(22))
+	data := make([]types.Point, 12)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(10)), float64(rng.Intn(10)), float64(rng.Intn(10))}
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Min}
+	aggs := []types.Aggregate{types.SumAggregate, types.MinAggregate, types.MaxAggregate}
+	for k := 2; k <= 3; k++ {
+		expected := bruteForceGroupSkyline(data, prefs, aggs, k)
+		// Groups tying with a returned group may be omitted, so compare distinct aggregates
+		got := aggregatesOf(GroupSkyline(data, prefs, aggs, k))
+		if !equalSkylineSet(distinct(got), distinct(expected)) {
+			t.Errorf("k=%d: expected aggregates %v, got %v", k, expected, got)
+		}
+	}
+}
+
+func TestGroupSkylineInvalidK(t *testing.T) {
+	data := []types.Point{{1, 1}, {2, 2}}
+	aggs := []types.Aggregate{types.SumAggregate, types.SumAggregate}
+	if got := GroupSkyline(data, types.Preference{types.Min, types.Min}, aggs, 3); got != nil {
+		t.Errorf("expected nil for k larger than the dataset, got %v", got)
+	}
+}
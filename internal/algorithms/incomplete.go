@@ -0,0 +1,82 @@
+package algorithms
+
+import (
+	"math"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// IncompleteSkyline computes the skyline of data with missing values, marked as NaN, under
+// utilities.DominatesIncomplete. That relation is not transitive, so a BNL window that drops
+// dominated points is incorrect: a dropped point may be the only one dominating another.
+//
+// Following ISkyline, points are bucketed by the set of dimensions they miss. Within a bucket all
+// points share their dimensions, dominance is transitive, and a point dominated inside its bucket
+// is never needed again: whatever it dominates, its dominator dominates as well. The local skylines
+// are then checked against each other, keeping every candidate as a potential dominator.
+func IncompleteSkyline(data []types.Point, prefs types.Preference) []types.Point {
+	var keys []string
+	buckets := make(map[string][]int)
+	for i, p := range data {
+		key := missingKey(p, prefs)
+		if _, ok := buckets[key]; !ok {
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], i)
+	}
+
+	var candidates []types.Point
+	for _, key := range keys {
+		candidates = append(candidates, localIncompleteSkyline(data, buckets[key], prefs)...)
+	}
+
+	var skyline []types.Point
+	for i, p := range candidates {
+		dominated := false
+		for j, q := range candidates {
+			if i != j && utilities.DominatesIncomplete(q, p, prefs, 0) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			skyline = append(skyline, p)
+		}
+	}
+	return skyline
+}
+
+// missingKey encodes the set of non-ignored dimensions in which p is NaN as a bitmap string.
+func missingKey(p types.Point, prefs types.Preference) string {
+	mask := make([]byte, (len(prefs)+7)/8)
+	for dim, order := range prefs {
+		if order != types.Ignore && math.IsNaN(p[dim]) {
+			mask[dim/8] |= 1 << (dim % 8)
+		}
+	}
+	return string(mask)
+}
+
+// localIncompleteSkyline runs BNL over the given points, which all miss the same dimensions.
+func localIncompleteSkyline(data []types.Point, idx []int, prefs types.Preference) []types.Point {
+	var window []types.Point
+	for _, i := range idx {
+		p := data[i]
+		dominated := false
+		for j := 0; j < len(window); {
+			if utilities.DominatesIncomplete(window[j], p, prefs, 0) {
+				dominated = true
+				break
+			} else if utilities.DominatesIncomplete(p, window[j], prefs, 0) {
+				window = append(window[:j], window[j+1:]...)
+			} else {
+				j++
+			}
+		}
+		if !dominated {
+			window = append(window, p)
+		}
+	}
+	return window
+}
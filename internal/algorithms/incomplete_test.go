@@ -0,0 +1,72 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestIncompleteSkylineMatchesPairwise(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	data := make(types.Dataset, 1500)
+	for i := range data {
+		p := make(types.Point, 4)
+		for d := range p {
+			if rng.Float64() < 0.25 {
+				p[d] = math.NaN()
+			} else {
+				p[d] = float64(rng.Intn(20))
+			}
+		}
+		data[i] = p
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Min, types.Ignore}
+
+	var expected types.Dataset
+	for _, p := range data {
+		dominated := false
+		for _, q := range data {
+			if utilities.DominatesIncomplete(q, p, prefs, 0) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			expected = append(expected, p)
+		}
+	}
+	got := IncompleteSkyline(data, prefs)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d skyline points, got %d", len(expected), len(got))
+	}
+	for _, p := range got {
+		for _, q := range data {
+			if utilities.DominatesIncomplete(q, p, prefs, 0) {
+				t.Errorf("%v is dominated by %v", p, q)
+			}
+		}
+	}
+}
+
+func TestIncompleteSkylineCycle(t *testing.T) {
+	// Each point dominates the next on the one dimension they share, so none is in the skyline.
+	// BNL would keep whichever point it saw last.
+	nan := math.NaN()
+	data := types.Dataset{{1, 2, nan}, {nan, 1, 2}, {2, nan, 1}}
+	prefs := types.Preference{types.Min, types.Min, types.Min}
+	if got := IncompleteSkyline(data, prefs); len(got) != 0 {
+		t.Errorf("expected an empty skyline, got %v", got)
+	}
+}
+
+func TestIncompleteSkylineComplete(t *testing.T) {
+	// Without missing values the result is the ordinary skyline
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min}
+	got := IncompleteSkyline(Dataset1000CoupleDominating4D, prefs)
+	if !equalSkylineSet(got, ExpectedSkyline1000CoupleDominating4D) {
+		t.Errorf("expected %v, got %v", ExpectedSkyline1000CoupleDominating4D, got)
+	}
+}
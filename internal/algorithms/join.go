@@ -0,0 +1,91 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// SkylineJoin computes the skyline of combine(l, r) over all pairs of left and right points with
+// equal join keys, without materializing the join.
+//
+// Left, right and joined points share the dimension layout of prefs, and combine must be monotone:
+// improving l or r in a dimension never worsens the joined point in that dimension, as with summed
+// prices or averaged ratings. Then a point dominated within its own join group only yields joined
+// points dominated by its dominator's, so each side of every group is reduced to its skyline first.
+// If combine is monotone but not strictly so (e.g. min or max), joined points that merely tie with
+// a result point may be left out.
+//
+// A group is skipped without joining if the combination of the best corners of its two sides,
+// which is at least as good as any of its joined points, is already dominated. Group skipping is
+// disabled when prefs has categorical dimensions.
+func SkylineJoin[K comparable](left, right []types.Point, joinKey func(types.Point) K, combine func(l, r types.Point) types.Point, prefs types.Preference) []types.Point {
+	leftGroups, keys := groupByKey(left, joinKey)
+	rightGroups, _ := groupByKey(right, joinKey)
+
+	useCorners := !hasCategorical(prefs)
+	var window bnlWindow[struct{}]
+	for _, key := range keys {
+		r, ok := rightGroups[key]
+		if !ok {
+			continue
+		}
+		skyL, skyR := SFS(leftGroups[key], prefs, BNLConfig{}), SFS(r, prefs, BNLConfig{})
+		if useCorners {
+			corner := combine(boundingBox(skyL, prefs).best, boundingBox(skyR, prefs).best)
+			if window.dominates(corner, prefs) {
+				continue
+			}
+		}
+		for _, l := range skyL {
+			for _, r := range skyR {
+				window.insert(combine(l, r), struct{}{}, prefs)
+			}
+		}
+	}
+	return window.points
+}
+
+// groupByKey splits points by key, returning the groups and the keys in order of first appearance.
+func groupByKey[K comparable](points []types.Point, key func(types.Point) K) (map[K][]types.Point, []K) {
+	groups := make(map[K][]types.Point)
+	var keys []K
+	for _, p := range points {
+		k := key(p)
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], p)
+	}
+	return groups, keys
+}
+
+// bnlWindow is a BNL window whose points carry a payload, such as the index of the object a point
+// stands for.
+type bnlWindow[P any] struct {
+	points   []types.Point
+	payloads []P
+}
+
+// dominates reports whether some point of the window dominates p.
+func (w *bnlWindow[P]) dominates(p types.Point, prefs types.Preference) bool {
+	for _, q := range w.points {
+		if utilities.DominatesEpsilon(q, p, prefs, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// insert adds p with its payload unless it is dominated, evicting the points p dominates.
+func (w *bnlWindow[P]) insert(p types.Point, payload P, prefs types.Preference) {
+	if w.dominates(p, prefs) {
+		return
+	}
+	points, payloads := w.points[:0], w.payloads[:0]
+	for i, q := range w.points {
+		if !utilities.DominatesEpsilon(p, q, prefs, 0) {
+			points, payloads = append(points, q), append(payloads, w.payloads[i])
+		}
+	}
+	w.points, w.payloads = append(points, p), append(payloads, payload)
+}
@@ -0,0 +1,52 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bundle joins a flight and a hotel: destination, summed price, average rating
+func bundle(l, r types.Point) types.Point {
+	return types.Point{l[0], l[1] + r[1], (l[2] + r[2]) / 2}
+}
+
+func destination(p types.Point) int {
+	return int(p[0])
+}
+
+func TestSkylineJoinMatchesFullJoin(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+	random := func(n int) []types.Point {
+		points := make([]types.Point, n)
+		for i := range points {
+			points[i] = types.Point{float64(rng.Intn(20)), float64(50 + rng.Intn(500)), float64(rng.Intn(10))}
+		}
+		return points
+	}
+	flights, hotels := random(400), random(600)
+	prefs := types.Preference{types.Ignore, types.Min, types.Max}
+
+	var joined types.Dataset
+	for _, f := range flights {
+		for _, h := range hotels {
+			if destination(f) == destination(h) {
+				joined = append(joined, bundle(f, h))
+			}
+		}
+	}
+	expected := BNL(joined, prefs, BNLConfig{})
+	got := SkylineJoin(flights, hotels, destination, bundle, prefs)
+	if !equalSkylineSet(got, expected) {
+		t.Errorf("expected %d joined skyline points, got %d", len(expected), len(got))
+	}
+}
+
+func TestSkylineJoinNoMatches(t *testing.T) {
+	left := []types.Point{{1, 100, 5}}
+	right := []types.Point{{2, 100, 5}}
+	if got := SkylineJoin(left, right, destination, bundle, types.Preference{types.Ignore, types.Min, types.Max}); len(got) != 0 {
+		t.Errorf("expected no joined points, got %v", got)
+	}
+}
@@ -0,0 +1,50 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// KDominantSkyline returns the points that are not k-dominated by any other point, using the
+// Two-Scan Algorithm. Because k-dominance is not transitive, a point discarded by a candidate
+// that is itself later discarded may still have been a valid witness, and a surviving candidate
+// may have been k-dominated by a point discarded earlier. The first scan therefore only builds a
+// candidate superset and the second scan verifies every candidate against the whole dataset.
+func KDominantSkyline[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, k int) S {
+	// First scan: candidates in monotone order, so strong points are met early
+	var candidates []int
+	for _, i := range monotoneOrder(data, prefs) {
+		p := data[i]
+		dominated := false
+		for j := 0; j < len(candidates); {
+			c := data[candidates[j]]
+			if !dominated && utilities.DominatesK(c, p, prefs, k) {
+				dominated = true
+			}
+			if utilities.DominatesK(p, c, prefs, k) {
+				candidates = append(candidates[:j], candidates[j+1:]...)
+			} else {
+				j++
+			}
+		}
+		if !dominated {
+			candidates = append(candidates, i)
+		}
+	}
+
+	// Second scan: drop false positives k-dominated by any point
+	var result S
+	for _, c := range candidates {
+		dominated := false
+		for _, p := range data {
+			if utilities.DominatesK(p, data[c], prefs, k) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, data[c])
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func bruteForceKDominant(data types.Dataset, prefs types.Preference, k int) types.Dataset {
+	var result types.Dataset
+	for _, p := range data {
+		dominated := false
+		for _, q := range data {
+			if utilities.DominatesK(q, p, prefs, k) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func TestKDominantSkyline(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min, types.Min, types.Min}
+	// Anti-correlated 6D data: almost every point is on the full skyline
+	data := make(types.Dataset, 0, 300)
+	for i := 0; i < 300; i++ {
+		x := float64((i * 37) % 101)
+		data = append(data, types.Point{x, 100 - x, float64((i * 13) % 17), float64((i * 7) % 23), float64(i % 11), float64((i * 5) % 19)})
+	}
+	for k := 3; k <= 6; k++ {
+		result := KDominantSkyline(data, prefs, k)
+		expected := bruteForceKDominant(data, prefs, k)
+		if !equalSkylineSet(result, expected) {
+			t.Errorf("k=%d: got %d points, want %d", k, len(result), len(expected))
+		}
+	}
+	full := BlockNestedLoop(data, prefs)
+	if got := KDominantSkyline(data, prefs, 6); !equalSkylineSet(got, full) {
+		t.Errorf("6-dominant skyline in 6D should equal the skyline: got %d points, want %d", len(got), len(full))
+	}
+}
+
+func TestKDominantSkyline_Cyclic(t *testing.T) {
+	// The points 2-dominate each other in a cycle, so no point survives
+	data := types.Dataset{{1, 2, 3}, {3, 1, 2}, {2, 3, 1}}
+	prefs := types.Preference{types.Min, types.Min, types.Min}
+	if result := KDominantSkyline(data, prefs, 2); len(result) != 0 {
+		t.Errorf("expected empty 2-dominant skyline for cyclic data, got %v", result)
+	}
+}
+
+func TestKDominantSkyline_Categorical(t *testing.T) {
+	po, err := types.NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	prefs := types.Preference{types.Min, types.Categorical(po)}
+	data := types.Dataset{{1, 0}, {2, 1}}
+	expected := types.Dataset{{1, 0}}
+	if got := KDominantSkyline(data, prefs, 2); !equalSkylineSet(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,58 @@
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// LayerRanks assigns every point its Pareto front: 1 for the skyline, 2 for the skyline of the
+// remaining points, and so on. Points beyond maxLayers get rank 0; maxLayers <= 0 means no limit.
+//
+// This is efficient non-dominated sorting with binary search: points are visited in monotone
+// order, so all dominators of a point are already placed, and a point belongs to the first
+// layer that contains none of them. If a layer contains no dominator then neither does any
+// later layer, which makes the search over layers binary.
+func LayerRanks[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, maxLayers int) []int {
+	ranks := make([]int, len(data))
+	var layers [][]int
+	for _, i := range monotoneOrder(data, prefs) {
+		l := sort.Search(len(layers), func(l int) bool {
+			return !dominatedByAny(data, layers[l], data[i], prefs)
+		})
+		if maxLayers > 0 && l >= maxLayers {
+			continue
+		}
+		if l == len(layers) {
+			layers = append(layers, nil)
+		}
+		layers[l] = append(layers[l], i)
+		ranks[i] = l + 1
+	}
+	return ranks
+}
+
+// SkylineLayers groups points into successive Pareto fronts, see LayerRanks.
+func SkylineLayers[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, maxLayers int) []S {
+	var layers []S
+	for i, rank := range LayerRanks(data, prefs, maxLayers) {
+		if rank == 0 {
+			continue
+		}
+		for len(layers) < rank {
+			layers = append(layers, nil)
+		}
+		layers[rank-1] = append(layers[rank-1], data[i])
+	}
+	return layers
+}
+
+func dominatedByAny[S ~[]types.PointOf[T], T types.Number](data S, members []int, p types.PointOf[T], prefs types.Preference) bool {
+	for _, m := range members {
+		if utilities.DominatesEpsilon(data[m], p, prefs, 0) {
+			return true
+		}
+	}
+	return false
+}
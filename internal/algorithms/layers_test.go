@@ -0,0 +1,69 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bruteForceLayers peels skylines off the remaining points until none are left
+func bruteForceLayers(data types.Dataset, prefs types.Preference) []types.Dataset {
+	var layers []types.Dataset
+	remaining := append(types.Dataset(nil), data...)
+	for len(remaining) > 0 {
+		layer := BlockNestedLoop(remaining, prefs)
+		layers = append(layers, layer)
+		var rest types.Dataset
+		for _, p := range remaining {
+			found := false
+			for _, q := range layer {
+				if isPointEqual(p, q) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				rest = append(rest, p)
+			}
+		}
+		remaining = rest
+	}
+	return layers
+}
+
+func TestSkylineLayers(t *testing.T) {
+	data := types.Dataset{
+		{1, 9}, {2, 8}, {3, 3}, {4, 6}, {5, 5}, {6, 7}, {7, 2}, {8, 1}, {9, 9}, {4, 4}, {5, 8}, {6, 6}, {3, 3},
+	}
+	prefs := types.Preference{types.Min, types.Max}
+	expected := bruteForceLayers(data, prefs)
+	result := SkylineLayers(data, prefs, 0)
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d layers, got %d: %v", len(expected), len(result), result)
+	}
+	for i := range expected {
+		if !equalSkylineSet(result[i], expected[i]) {
+			t.Errorf("layer %d: got %v, want %v", i+1, result[i], expected[i])
+		}
+	}
+
+	limited := SkylineLayers(data, prefs, 2)
+	if len(limited) != 2 || !equalSkylineSet(limited[1], expected[1]) {
+		t.Errorf("maxLayers=2: got %v", limited)
+	}
+}
+
+func TestLayerRanks(t *testing.T) {
+	data := types.Dataset{{3, 3}, {1, 1}, {2, 2}, {0, 5}, {2, 2}}
+	prefs := types.Preference{types.Min, types.Min}
+	want := []int{3, 1, 2, 1, 2}
+	got := LayerRanks(data, prefs, 0)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("point %v: expected rank %d, got %d", data[i], want[i], got[i])
+		}
+	}
+	if got := LayerRanks(data, prefs, 1); got[0] != 0 || got[2] != 0 || got[1] != 1 {
+		t.Errorf("maxLayers=1: expected points beyond the skyline to have rank 0, got %v", got)
+	}
+}
@@ -0,0 +1,208 @@
+package algorithms
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// The Matrix variants below run on row indices instead of Points, so the hot loops only
+// slice into the matrix backing array and never allocate per point. They return the
+// indices of the skyline rows in m.
+
+// BNLMatrix computes the skyline of a matrix using Block Nested Loop.
+func BNLMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, cfg BNLConfig) []int {
+	return bnlIndices(m, allRows(m), prefs, T(cfg.Epsilon), cfg.Constraints)
+}
+
+// SFSMatrix computes the skyline of a matrix using Sort-Filter-Skyline (see SFS).
+func SFSMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, cfg BNLConfig) []int {
+	order := monotoneOrderFunc(m.Rows, prefs, func(i, dim int, o types.Order) float64 {
+		return orientedValue(m.Row(i), dim, o)
+	})
+	return bnlIndices(m, order, prefs, T(cfg.Epsilon), cfg.Constraints)
+}
+
+// DivideAndConquerMatrix computes the skyline of a matrix using Divide & Conquer.
+func DivideAndConquerMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, cfg *types.DNCConfig) []int {
+	if cfg == nil {
+		cfg = &defaultDNCConfig
+	}
+	return dncIndices(m, allRows(m), prefs, cfg)
+}
+
+// SkyTreeMatrix computes the skyline of a matrix using SkyTree.
+// Pivots are always chosen with the median strategy, cfg.PivotSelector is not consulted
+// because it operates on Datasets.
+func SkyTreeMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, cfg SkyTreeConfig) []int {
+	return skyTreeIndices(m, allRows(m), prefs, cfg)
+}
+
+func allRows[T types.Number](m types.MatrixOf[T]) []int {
+	idx := make([]int, m.Rows)
+	for i := range idx {
+		idx[i] = i
+	}
+	return idx
+}
+
+// bnlIndices is BNL over a subset of matrix rows.
+func bnlIndices[T types.Number](m types.MatrixOf[T], idx []int, prefs types.Preference, epsilon T, constraints types.Constraints) []int {
+	var window []int
+	for _, p := range idx {
+		row := m.Row(p)
+		if !utilities.Satisfies(row, constraints) {
+			continue
+		}
+		dominated := false
+		for i := 0; i < len(window); {
+			w := m.Row(window[i])
+			if utilities.DominatesEpsilon(w, row, prefs, epsilon) {
+				dominated = true
+				break
+			} else if utilities.DominatesEpsilon(row, w, prefs, epsilon) {
+				window = append(window[:i], window[i+1:]...)
+			} else {
+				i++
+			}
+		}
+		if !dominated {
+			window = append(window, p)
+		}
+	}
+	return window
+}
+
+// dncIndices mirrors DivideAndConquer. idx is sorted and split in place, so both halves
+// share the caller's slice and only the partial skylines are allocated.
+func dncIndices[T types.Number](m types.MatrixOf[T], idx []int, prefs types.Preference, cfg *types.DNCConfig) []int {
+	if len(idx) <= cfg.Threshold || len(idx) < 2 {
+		return bnlIndices(m, idx, prefs, T(cfg.Epsilon), cfg.Constraints)
+	}
+
+	// Find dimension with largest range
+	var maxRange T
+	splitDim := 0
+	for d := 0; d < m.Cols; d++ {
+		minVal, maxVal := m.At(idx[0], d), m.At(idx[0], d)
+		for _, i := range idx {
+			v := m.At(i, d)
+			if v < minVal {
+				minVal = v
+			}
+			if v > maxVal {
+				maxVal = v
+			}
+		}
+		if maxVal-minVal > maxRange {
+			maxRange = maxVal - minVal
+			splitDim = d
+		}
+	}
+
+	sort.Slice(idx, func(i, j int) bool {
+		return m.At(idx[i], splitDim) < m.At(idx[j], splitDim)
+	})
+	mid := len(idx) / 2
+
+	var leftSkyline, rightSkyline []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		leftSkyline = dncIndices(m, idx[:mid], prefs, cfg)
+		wg.Done()
+	}()
+	go func() {
+		rightSkyline = dncIndices(m, idx[mid:], prefs, cfg)
+		wg.Done()
+	}()
+	wg.Wait()
+
+	epsilon := T(cfg.Epsilon)
+	merged := make([]int, 0, len(leftSkyline)+len(rightSkyline))
+	merged = appendNonDominatedIndices(merged, m, leftSkyline, rightSkyline, prefs, epsilon)
+	merged = appendNonDominatedIndices(merged, m, rightSkyline, leftSkyline, prefs, epsilon)
+	return merged
+}
+
+func appendNonDominatedIndices[T types.Number](merged []int, m types.MatrixOf[T], src, other []int, prefs types.Preference, epsilon T) []int {
+	for _, p := range src {
+		dominated := false
+		for _, q := range other {
+			if utilities.DominatesEpsilon(m.Row(q), m.Row(p), prefs, epsilon) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
+
+// skyTreeIndices mirrors SkyTree over a subset of matrix rows.
+func skyTreeIndices[T types.Number](m types.MatrixOf[T], idx []int, prefs types.Preference, cfg SkyTreeConfig) []int {
+	if len(idx) <= cfg.BNLSwitchThreshold || len(idx) <= 1 {
+		return bnlIndices(m, idx, prefs, T(cfg.Epsilon), cfg.Constraints)
+	}
+
+	pivot := m.Row(medianPivotIndex(m, idx))
+
+	// Partition rows by region relative to pivot, keeping copies of the pivot aside
+	var equalToPivot []int
+	partitions := make(map[int][]int)
+	for _, i := range idx {
+		row := m.Row(i)
+		if !utilities.Satisfies(row, cfg.Constraints) {
+			continue
+		}
+		if isPointEqual(row, pivot) {
+			equalToPivot = append(equalToPivot, i)
+			continue
+		}
+		mask := regionMaskBit(row, pivot, prefs)
+		partitions[mask] = append(partitions[mask], i)
+	}
+
+	result := make([]int, 0, len(idx))
+	for _, subset := range partitions {
+		result = append(result, skyTreeIndices(m, subset, prefs, cfg)...)
+	}
+	result = append(result, equalToPivot...)
+
+	return bnlIndices(m, result, prefs, T(cfg.Epsilon), nil)
+}
+
+// medianPivotIndex returns the row closest to the per-dimension medians, like SelectMedianPivot.
+func medianPivotIndex[T types.Number](m types.MatrixOf[T], idx []int) int {
+	n := len(idx)
+	medians := make([]T, m.Cols)
+	vals := make([]T, n)
+	for d := range medians {
+		for j, i := range idx {
+			vals[j] = m.At(i, d)
+		}
+		slices.Sort(vals)
+		if n%2 == 0 {
+			medians[d] = (vals[n/2-1] + vals[n/2]) / 2
+		} else {
+			medians[d] = vals[n/2]
+		}
+	}
+	best, bestDist := idx[0], -1.0
+	for _, i := range idx {
+		dist := 0.0
+		for d, med := range medians {
+			diff := float64(m.At(i, d)) - float64(med)
+			dist += diff * diff
+		}
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+	return best
+}
@@ -0,0 +1,53 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestMatrix_Skyline(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    types.Dataset
+		expected types.Dataset
+		prefs    types.Preference
+	}{
+		{"5SomeDominating", Dataset5SomeDominating, ExpectedSkyline5SomeDominating, types.Preference{types.Min, types.Max}},
+		{"Empty", DatasetEmpty, ExpectedSkylineEmpty, types.Preference{types.Min, types.Max}},
+		{"Single", DatasetSingle, ExpectedSkylineSingle, types.Preference{types.Min, types.Max}},
+		{"AllSame", DatasetAllSame, ExpectedSkylineAllSame, types.Preference{types.Min, types.Max}},
+		{"5000CoupleDominating", Dataset5000CoupleDominating, ExpectedSkyline5000CoupleDominating, types.Preference{types.Min, types.Max}},
+		{"1000CoupleDominating4D", Dataset1000CoupleDominating4D, ExpectedSkyline1000CoupleDominating4D, types.Preference{types.Min, types.Min, types.Min, types.Min}},
+		{"2000SmallSkyline8D", Dataset2000SmallSkyline8D, ExpectedSkyline2000SmallSkyline8D, types.Preference{types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min}},
+		{"64Clusters4D", Dataset64Clusters4D, ExpectedSkyline64Clusters4D, types.Preference{types.Min, types.Min, types.Min, types.Min}},
+	}
+	algos := map[string]func(types.Matrix, types.Preference) []int{
+		"bnl": func(m types.Matrix, prefs types.Preference) []int { return BNLMatrix(m, prefs, BNLConfig{}) },
+		"sfs": func(m types.Matrix, prefs types.Preference) []int { return SFSMatrix(m, prefs, BNLConfig{}) },
+		"dnc": func(m types.Matrix, prefs types.Preference) []int { return DivideAndConquerMatrix(m, prefs, nil) },
+		"skytree": func(m types.Matrix, prefs types.Preference) []int {
+			return SkyTreeMatrix(m, prefs, DefaultSkyTreeConfig)
+		},
+	}
+	for _, tc := range tests {
+		m := types.MatrixFromDataset(tc.input)
+		for algo, run := range algos {
+			t.Run(tc.name+"/"+algo, func(t *testing.T) {
+				result := m.Select(run(m, tc.prefs)).Dataset()
+				if !equalSkylineSet(result, tc.expected) {
+					t.Errorf("%s matrix skyline incorrect for %s: got %v, want %v", algo, tc.name, result, tc.expected)
+				}
+			})
+		}
+	}
+}
+
+func TestMatrix_Stride(t *testing.T) {
+	// Two rows of two values, each padded with an extra column that must be ignored
+	m := types.Matrix{Data: []float64{1, 5, -100, 2, 6, 100}, Rows: 2, Cols: 2, Stride: 3}
+	result := BNLMatrix(m, types.Preference{types.Min, types.Min}, BNLConfig{})
+	if len(result) != 1 || result[0] != 0 {
+		t.Errorf("expected only row 0 in skyline, got %v", result)
+	}
+}
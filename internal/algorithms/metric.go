@@ -0,0 +1,99 @@
+package algorithms
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// MetricSkyline returns the indices of the objects whose vector of distances to the queries,
+// all minimized, is not dominated by another object's. Objects can be of any type, such as
+// strings under edit distance, as long as metric satisfies the triangle inequality.
+//
+// Distances are computed lazily. By the triangle inequality |d(o, q_j) - d(q_j, q_i)| bounds
+// d(o, q_i) from below for every computed d(o, q_j), so an object whose lower bounds are already
+// dominated by a window object is discarded without computing its remaining distances. Objects are
+// visited by increasing distance to the first query, which dominators never exceed.
+func MetricSkyline[O any](objects, queries []O, metric func(a, b O) float64) []int {
+	if len(queries) == 0 {
+		return allIndices(len(objects))
+	}
+	between := make([][]float64, len(queries))
+	for i := range queries {
+		between[i] = make([]float64, len(queries))
+		for j := range queries {
+			if i != j {
+				between[i][j] = metric(queries[i], queries[j])
+			}
+		}
+	}
+
+	first := make([]float64, len(objects))
+	for o := range objects {
+		first[o] = metric(objects[o], queries[0])
+	}
+	order := allIndices(len(objects))
+	sort.SliceStable(order, func(i, j int) bool { return first[order[i]] < first[order[j]] })
+
+	prefs := make(types.Preference, len(queries))
+	for i := range prefs {
+		prefs[i] = types.Min
+	}
+	var window bnlWindow[int]
+	for _, o := range order {
+		bounds := newMetricBounds(first[o], between)
+		for next := bounds.nextUnknown(); next >= 0 && !window.dominates(bounds.lower, prefs); next = bounds.nextUnknown() {
+			bounds.set(next, metric(objects[o], queries[next]), between)
+		}
+		// An object whose loop stopped at lower bounds is dominated, and insert drops it
+		window.insert(bounds.lower, o, prefs)
+	}
+	return window.payloads
+}
+
+// MetricTransformSkyline computes the skyline of data under t with MetricSkyline's pruning when t
+// is a MetricTransform whose distances are all minimized, and reports whether it did.
+func MetricTransformSkyline(data []types.Point, prefs types.Preference, t types.Transform) ([]int, bool) {
+	mt, ok := t.(types.MetricTransform)
+	if !ok || len(prefs) != len(mt.Queries) {
+		return nil, false
+	}
+	for _, order := range prefs {
+		if order != types.Min {
+			return nil, false
+		}
+	}
+	return MetricSkyline(data, mt.Queries, mt.Metric), true
+}
+
+// metricBounds holds lower bounds on an object's distances to the queries, exact where known.
+type metricBounds struct {
+	lower types.Point
+	known []bool
+}
+
+func newMetricBounds(d0 float64, between [][]float64) metricBounds {
+	b := metricBounds{lower: make(types.Point, len(between)), known: make([]bool, len(between))}
+	b.set(0, d0, between)
+	return b
+}
+
+// set records the exact distance to query j and tightens the bounds of the unknown distances.
+func (b metricBounds) set(j int, d float64, between [][]float64) {
+	b.lower[j], b.known[j] = d, true
+	for i, k := range b.known {
+		if !k {
+			b.lower[i] = max(b.lower[i], math.Abs(d-between[j][i]))
+		}
+	}
+}
+
+func (b metricBounds) nextUnknown() int {
+	for i, k := range b.known {
+		if !k {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,106 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func manhattan(a, b types.Point) float64 {
+	return math.Abs(a[0]-b[0]) + math.Abs(a[1]-b[1])
+}
+
+func TestMetricSkylineMatchesTransform(t *testing.T) {
+	rng := rand.New(rand.NewSource(49))
+	data := make([]types.Point, 300)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(100)), float64(rng.Intn(100))}
+	}
+	queries := []types.Point{{30, 30}, {70, 40}, {50, 80}}
+
+	calls := 0
+	counting := func(a, b types.Point) float64 {
+		calls++
+		return manhattan(a, b)
+	}
+	var got types.Dataset
+	for _, i := range MetricSkyline(data, queries, counting) {
+		got = append(got, data[i])
+	}
+
+	prefs := types.Preference{types.Min, types.Min, types.Min}
+	var want types.Dataset
+	tr := types.MetricTransform{Queries: queries, Metric: manhattan}
+	for _, p := range data {
+		dominated := false
+		for _, q := range data {
+			if utilities.DominatesTransform(q, p, prefs, tr, 0) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			want = append(want, p)
+		}
+	}
+	if !equalSkylineSet(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if full := len(data)*len(queries) + len(queries)*(len(queries)-1); calls >= full {
+		t.Errorf("expected triangle-inequality pruning to save distance computations, got %d of %d", calls, full)
+	}
+}
+
+func TestMetricSkylineStrings(t *testing.T) {
+	// Discrete metric: 0 if equal, 1 otherwise
+	discrete := func(a, b string) float64 {
+		if a == b {
+			return 0
+		}
+		return 1
+	}
+	objects := []string{"x", "a", "b", "a"}
+	got := MetricSkyline(objects, []string{"a", "b"}, discrete)
+	slices.Sort(got)
+	// "a" and "b" each match one query, "x" matches none
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestMetricSkylineNoQueries(t *testing.T) {
+	got := MetricSkyline([]int{1, 2, 3}, nil, func(a, b int) float64 { return 0 })
+	if len(got) != 3 {
+		t.Errorf("expected every object without queries, got %v", got)
+	}
+}
+
+func TestTransformedSkylineMetricPrunes(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	data := make([]types.Point, 300)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(100)), float64(rng.Intn(100))}
+	}
+	queries := []types.Point{{30, 30}, {70, 40}, {50, 80}}
+	prefs := types.Preference{types.Min, types.Min, types.Min}
+
+	calls := 0
+	counting := types.MetricTransform{Queries: queries, Metric: func(a, b types.Point) float64 {
+		calls++
+		return manhattan(a, b)
+	}}
+	got := TransformedSkyline(data, prefs, counting, BNLConfig{})
+
+	view := TransformView(data, len(prefs), types.MetricTransform{Queries: queries, Metric: manhattan})
+	want := selectPoints(data, BNLMatrix(view, prefs, BNLConfig{}))
+	if !equalSkylineSet(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if calls >= len(data)*len(queries) {
+		t.Errorf("expected a MetricTransform to be pruned, got %d distance computations", calls)
+	}
+}
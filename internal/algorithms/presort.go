@@ -0,0 +1,71 @@
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// monotoneOrder returns the indices of data sorted by a score that is monotone with respect to
+// dominance: the sum of the oriented coordinates (Max dimensions negated, categorical dimensions
+// replaced by their depth in the partial order, Ignore skipped), with ties broken
+// lexicographically. If a dominates b then a sorts strictly before b, so a point can only be
+// dominated by points that precede it. This does not hold for epsilon dominance.
+func monotoneOrder[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference) []int {
+	return monotoneOrderFunc(len(data), prefs, func(i, dim int, order types.Order) float64 {
+		return orientedValue(data[i], dim, order)
+	})
+}
+
+// monotoneOrderFunc is monotoneOrder over n items whose oriented coordinates are given by value.
+func monotoneOrderFunc(n int, prefs types.Preference, value func(i, dim int, order types.Order) float64) []int {
+	scores := make([]float64, n)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+		for dim, order := range prefs {
+			scores[i] += value(i, dim, order)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := idx[i], idx[j]
+		if scores[a] != scores[b] {
+			return scores[a] < scores[b]
+		}
+		for dim, order := range prefs {
+			av, bv := value(a, dim, order), value(b, dim, order)
+			if av != bv {
+				return av < bv
+			}
+		}
+		return false
+	})
+	return idx
+}
+
+// orientedValue returns the coordinate of p in dim such that smaller is always better.
+// For a categorical dimension this is the depth of the category, which only respects preferences.
+func orientedValue[T types.Number](p types.PointOf[T], dim int, order types.Order) float64 {
+	switch order {
+	case types.Min:
+		return float64(p[dim])
+	case types.Max:
+		return -float64(p[dim])
+	default:
+		if po := order.PartialOrder(); po != nil {
+			return float64(po.Depth(int(p[dim])))
+		}
+		return 0
+	}
+}
+
+// hasCategorical reports whether prefs has a categorical dimension, for which per-dimension
+// bounds such as bounding boxes are meaningless.
+func hasCategorical(prefs types.Preference) bool {
+	for _, order := range prefs {
+		if order.PartialOrder() != nil {
+			return true
+		}
+	}
+	return false
+}
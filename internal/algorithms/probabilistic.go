@@ -0,0 +1,146 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// SkylineProbabilities returns, for each uncertain object, the probability that it is in the
+// skyline when every object independently takes one of its instances:
+//
+//	Pr(U) = sum over instances u of p(u) * product over V != U of (1 - sum of p(v) over v in V dominating u)
+//
+// Each object's instances are summarized by their minimum bounding rectangle (MBR). If the best
+// corner of V's MBR does not dominate u, no instance of V does, and if the worst corner does,
+// all of them do, so most objects are settled without looking at their instances. An object whose
+// MBR is entirely dominated by a certain object's MBR has probability 0.
+// MBR pruning is skipped when prefs has categorical dimensions.
+func SkylineProbabilities(objects []types.UncertainPoint, prefs types.Preference) []float64 {
+	return skylineProbabilities(objects, prefs, 0)
+}
+
+// ProbabilisticSkyline returns the indices of the objects whose skyline probability is at least
+// threshold. On top of MBR pruning, an object is abandoned as soon as its accumulated probability
+// plus the mass of its remaining instances falls below threshold.
+func ProbabilisticSkyline(objects []types.UncertainPoint, prefs types.Preference, threshold float64) []int {
+	var result []int
+	for i, p := range skylineProbabilities(objects, prefs, threshold) {
+		if p >= threshold && len(objects[i].Instances) > 0 {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// mbr holds the best and worst corners of an object's instances under prefs.
+type mbr struct {
+	best, worst types.Point
+}
+
+type uncertainIndex struct {
+	objects []types.UncertainPoint
+	prefs   types.Preference
+	boxes   []mbr
+	masses  []float64
+	useMBR  bool
+}
+
+// skylineProbabilities computes exact probabilities for objects reaching threshold; the values
+// of the others are lower bounds below threshold.
+func skylineProbabilities(objects []types.UncertainPoint, prefs types.Preference, threshold float64) []float64 {
+	idx := uncertainIndex{
+		objects: objects,
+		prefs:   prefs,
+		boxes:   make([]mbr, len(objects)),
+		masses:  make([]float64, len(objects)),
+		useMBR:  !hasCategorical(prefs),
+	}
+	for i, o := range objects {
+		idx.boxes[i] = boundingBox(o.Instances, prefs)
+		for k := range o.Instances {
+			idx.masses[i] += o.Prob(k)
+		}
+	}
+
+	probs := make([]float64, len(objects))
+	for i, u := range objects {
+		if idx.certainlyDominated(i) {
+			continue
+		}
+		remaining := idx.masses[i]
+		for k, inst := range u.Instances {
+			p := u.Prob(k)
+			remaining -= p
+			probs[i] += p * idx.survival(inst, i)
+			if probs[i]+remaining < threshold {
+				break
+			}
+		}
+	}
+	return probs
+}
+
+// certainlyDominated reports whether some object that surely exists dominates every instance of object i.
+func (idx *uncertainIndex) certainlyDominated(i int) bool {
+	if !idx.useMBR || len(idx.objects[i].Instances) == 0 {
+		return false
+	}
+	for j, box := range idx.boxes {
+		if j != i && box.worst != nil && idx.masses[j] >= 1-1e-12 &&
+			utilities.DominatesEpsilon(box.worst, idx.boxes[i].best, idx.prefs, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// survival returns the probability that no object other than self dominates inst.
+func (idx *uncertainIndex) survival(inst types.Point, self int) float64 {
+	s := 1.0
+	for j, v := range idx.objects {
+		if j == self || len(v.Instances) == 0 {
+			continue
+		}
+		box := idx.boxes[j]
+		if idx.useMBR && !utilities.DominatesEpsilon(box.best, inst, idx.prefs, 0) {
+			continue
+		}
+		if idx.useMBR && utilities.DominatesEpsilon(box.worst, inst, idx.prefs, 0) {
+			s *= 1 - idx.masses[j]
+		} else {
+			dominating := 0.0
+			for k, w := range v.Instances {
+				if utilities.DominatesEpsilon(w, inst, idx.prefs, 0) {
+					dominating += v.Prob(k)
+				}
+			}
+			s *= 1 - dominating
+		}
+		if s <= 0 {
+			return 0
+		}
+	}
+	return s
+}
+
+// boundingBox returns the best and worst corners of points under prefs, or a zero mbr if there are none.
+func boundingBox(points []types.Point, prefs types.Preference) mbr {
+	if len(points) == 0 {
+		return mbr{}
+	}
+	box := mbr{
+		best:  append(types.Point(nil), points[0]...),
+		worst: append(types.Point(nil), points[0]...),
+	}
+	for _, p := range points[1:] {
+		for dim, order := range prefs {
+			switch order {
+			case types.Min:
+				box.best[dim], box.worst[dim] = min(box.best[dim], p[dim]), max(box.worst[dim], p[dim])
+			case types.Max:
+				box.best[dim], box.worst[dim] = max(box.best[dim], p[dim]), min(box.worst[dim], p[dim])
+			}
+		}
+	}
+	return box
+}
@@ -0,0 +1,109 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// naiveSkylineProbability evaluates the skyline probability formula without pruning
+func naiveSkylineProbability(objects []types.UncertainPoint, prefs types.Preference, i int) float64 {
+	total := 0.0
+	for k, u := range objects[i].Instances {
+		s := objects[i].Prob(k)
+		for j, v := range objects {
+			if j == i {
+				continue
+			}
+			dominating := 0.0
+			for m, w := range v.Instances {
+				if utilities.DominatesEpsilon(w, u, prefs, 0) {
+					dominating += v.Prob(m)
+				}
+			}
+			s *= 1 - dominating
+		}
+		total += s
+	}
+	return total
+}
+
+func randomUncertain(rng *rand.Rand, n int) []types.UncertainPoint {
+	objects := make([]types.UncertainPoint, n)
+	for i := range objects {
+		cx, cy := rng.Float64()*100, rng.Float64()*100
+		m := 1 + rng.Intn(5)
+		o := types.UncertainPoint{Instances: make([]types.Point, m)}
+		for k := range o.Instances {
+			o.Instances[k] = types.Point{cx + rng.Float64()*10, cy + rng.Float64()*10}
+		}
+		if rng.Intn(2) == 0 {
+			o.Probs = make([]float64, m)
+			for k := range o.Probs {
+				o.Probs[k] = 0.9 / float64(m)
+			}
+		}
+		objects[i] = o
+	}
+	return objects
+}
+
+func TestSkylineProbabilitiesMatchNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	objects := randomUncertain(rng, 200)
+	prefs := types.Preference{types.Min, types.Max}
+	probs := SkylineProbabilities(objects, prefs)
+	for i := range objects {
+		if want := naiveSkylineProbability(objects, prefs, i); math.Abs(probs[i]-want) > 1e-9 {
+			t.Errorf("object %d: expected %v, got %v", i, want, probs[i])
+		}
+	}
+}
+
+func TestProbabilisticSkylineThreshold(t *testing.T) {
+	rng := rand.New(rand.NewSource(8))
+	objects := randomUncertain(rng, 300)
+	prefs := types.Preference{types.Min, types.Min}
+	for _, threshold := range []float64{0.1, 0.5, 0.9} {
+		got := ProbabilisticSkyline(objects, prefs, threshold)
+		var expected []int
+		for i := range objects {
+			if naiveSkylineProbability(objects, prefs, i) >= threshold {
+				expected = append(expected, i)
+			}
+		}
+		if len(got) != len(expected) {
+			t.Fatalf("threshold %v: expected %v, got %v", threshold, expected, got)
+		}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("threshold %v: expected %v, got %v", threshold, expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestSkylineProbabilitiesCertainObjects(t *testing.T) {
+	// Objects with a single instance are in the skyline with probability 0 or 1
+	var objects []types.UncertainPoint
+	for _, p := range Dataset5SomeDominating {
+		objects = append(objects, types.UncertainPoint{Instances: []types.Point{p}})
+	}
+	prefs := types.Preference{types.Min, types.Max}
+	var sky types.Dataset
+	for i, p := range SkylineProbabilities(objects, prefs) {
+		if p != 0 && p != 1 {
+			t.Errorf("object %d: expected probability 0 or 1, got %v", i, p)
+		}
+		if p == 1 {
+			sky = append(sky, objects[i].Instances[0])
+		}
+	}
+	if !equalSkylineSet(sky, ExpectedSkyline5SomeDominating) {
+		t.Errorf("expected %v, got %v", ExpectedSkyline5SomeDominating, sky)
+	}
+}
@@ -0,0 +1,130 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/lp"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// KRegret greedily selects k points minimizing the maximum regret ratio over all linear utility
+// functions with non-negative weights, and returns them with the regret ratio they achieve.
+// The regret ratio of a set S for a utility u is (max u(data) - max u(S)) / max u(data).
+//
+// Each non-ignored dimension is first rescaled to [0, 1] with 1 the best value, so Min and Max
+// dimensions are treated alike. Only skyline points can be the best for such a utility, so the
+// search runs on the skyline. Starting from the point that is best in the first dimension, the
+// point whose worst-case regret against the current selection is largest is added next; that
+// regret is the optimum of a linear program over the utility weights (Nanongkai et al., 2010).
+func KRegret(data []types.Point, prefs types.Preference, k int) ([]types.Point, float64, error) {
+	if len(data) == 0 {
+		return nil, 0, nil
+	}
+	if k <= 0 {
+		return nil, 1, nil
+	}
+	sky, _ := splitSkyline(data, prefs)
+	if k >= len(sky) {
+		return sky, 0, nil
+	}
+
+	norm := normalizeUtility(sky, prefs)
+	chosen := []int{bestInFirstDimension(norm)}
+	for {
+		worst, regret, err := maxRegret(norm, chosen)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(chosen) == k || regret <= 0 {
+			result := make([]types.Point, len(chosen))
+			for i, c := range chosen {
+				result[i] = sky[c]
+			}
+			return result, regret, nil
+		}
+		chosen = append(chosen, worst)
+	}
+}
+
+// normalizeUtility maps the non-ignored coordinates of data to [0, 1], with 1 the best value.
+// A dimension in which all points agree maps to 1.
+func normalizeUtility(data []types.Point, prefs types.Preference) []types.Point {
+	var dims []int
+	for dim, order := range prefs {
+		if order != types.Ignore {
+			dims = append(dims, dim)
+		}
+	}
+	lo, hi := orientedBounds(data, prefs)
+	out := make([]types.Point, len(data))
+	for i, p := range data {
+		out[i] = make(types.Point, len(dims))
+		for j, dim := range dims {
+			out[i][j] = 1
+			if hi[dim] > lo[dim] {
+				out[i][j] = (hi[dim] - orientedValue(p, dim, prefs[dim])) / (hi[dim] - lo[dim])
+			}
+		}
+	}
+	return out
+}
+
+func bestInFirstDimension(norm []types.Point) int {
+	best := 0
+	for i, p := range norm {
+		if len(p) > 0 && p[0] > norm[best][0] {
+			best = i
+		}
+	}
+	return best
+}
+
+// maxRegret returns the point with the largest regret ratio against the chosen points, and that ratio.
+func maxRegret(norm []types.Point, chosen []int) (int, float64, error) {
+	selected := make([]bool, len(norm))
+	for _, c := range chosen {
+		selected[c] = true
+	}
+	worst, regret := -1, 0.0
+	for i := range norm {
+		if selected[i] {
+			continue
+		}
+		r, err := regretRatio(norm[i], norm, chosen)
+		if err != nil {
+			return -1, 0, err
+		}
+		if r > regret {
+			worst, regret = i, r
+		}
+	}
+	return worst, regret, nil
+}
+
+// regretRatio finds the utility weights w >= 0 for which p beats the chosen points by the widest
+// margin relative to w·p. Fixing the scale with w·p <= 1, it solves
+//
+//	max x  s.t.  w·(s - p) + x <= 0 for every chosen s,  w·p <= 1,  w, x >= 0
+//
+// whose optimum is the largest regret ratio attained when p is the best point.
+func regretRatio(p types.Point, norm []types.Point, chosen []int) (float64, error) {
+	d := len(p)
+	c := make([]float64, d+1)
+	c[d] = 1
+	A := make([][]float64, 0, len(chosen)+1)
+	b := make([]float64, 0, len(chosen)+1)
+	for _, idx := range chosen {
+		row := make([]float64, d+1)
+		for j := range p {
+			row[j] = norm[idx][j] - p[j]
+		}
+		row[d] = 1
+		A = append(A, row)
+		b = append(b, 0)
+	}
+	row := make([]float64, d+1)
+	copy(row, p)
+	A = append(A, row)
+	b = append(b, 1)
+
+	_, value, err := lp.Maximize(c, A, b)
+	return value, err
+}
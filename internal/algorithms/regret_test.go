@@ -0,0 +1,79 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// sampledRegret approximates the maximum regret ratio of reps in 2D by sweeping the weight angle
+func sampledRegret(data, reps []types.Point) float64 {
+	worst := 0.0
+	for step := 0; step <= 10000; step++ {
+		angle := math.Pi / 2 * float64(step) / 10000
+		w0, w1 := math.Cos(angle), math.Sin(angle)
+		best, got := 0.0, 0.0
+		for _, p := range data {
+			best = math.Max(best, w0*p[0]+w1*p[1])
+		}
+		for _, p := range reps {
+			got = math.Max(got, w0*p[0]+w1*p[1])
+		}
+		if best > 0 {
+			worst = math.Max(worst, (best-got)/best)
+		}
+	}
+	return worst
+}
+
+func TestKRegretReportsAchievedRatio(t *testing.T) {
+	// Points already in [0, 1] with the extremes present, so normalization is the identity
+	data := []types.Point{{1, 0}, {0, 1}, {0.9, 0.5}, {0.7, 0.7}, {0.5, 0.9}, {0.4, 0.4}, {0.2, 0.1}}
+	prefs := types.Preference{types.Max, types.Max}
+	for k := 1; k <= 5; k++ {
+		reps, regret, err := KRegret(data, prefs, k)
+		if err != nil {
+			t.Fatalf("k=%d: unexpected error: %v", k, err)
+		}
+		if len(reps) > k {
+			t.Errorf("k=%d: got %d points", k, len(reps))
+		}
+		if sampled := sampledRegret(data, reps); math.Abs(sampled-regret) > 1e-4 {
+			t.Errorf("k=%d: reported regret %v, sampled %v", k, regret, sampled)
+		}
+	}
+}
+
+func TestKRegretDecreasesWithK(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	data := make([]types.Point, 300)
+	for i := range data {
+		data[i] = types.Point{rng.Float64(), rng.Float64(), rng.Float64()}
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Min}
+	prev := 1.0
+	for k := 1; k <= 8; k++ {
+		_, regret, err := KRegret(data, prefs, k)
+		if err != nil {
+			t.Fatalf("k=%d: unexpected error: %v", k, err)
+		}
+		if regret < 0 || regret > prev+1e-9 {
+			t.Errorf("k=%d: regret %v after %v", k, regret, prev)
+		}
+		prev = regret
+	}
+}
+
+func TestKRegretWholeSkyline(t *testing.T) {
+	data := []types.Point{{1, 3}, {2, 2}, {3, 1}, {3, 3}}
+	prefs := types.Preference{types.Min, types.Min}
+	reps, regret, err := KRegret(data, prefs, 10)
+	if err != nil || regret != 0 || !equalSkylineSet(reps, types.Dataset{{1, 3}, {2, 2}, {3, 1}}) {
+		t.Errorf("expected the whole skyline with zero regret, got %v, %v, %v", reps, regret, err)
+	}
+	if reps, regret, _ := KRegret(data, prefs, 0); reps != nil || regret != 1 {
+		t.Errorf("expected no points and regret 1 for k=0, got %v, %v", reps, regret)
+	}
+}
@@ -0,0 +1,317 @@
+package algorithms
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// RepresentativeMaxDominance picks k skyline points that together dominate the most
+// non-skyline points. In two dimensions the points each skyline point dominates are contiguous
+// along the staircase, so an exact dynamic program is used. Maximum coverage is NP-hard beyond two
+// dimensions, so points are chosen greedily there, which guarantees a (1 - 1/e) approximation.
+func RepresentativeMaxDominance(data []types.Point, prefs types.Preference, k int) []types.Point {
+	sky, rest := splitSkyline(data, prefs)
+	if k >= len(sky) {
+		return sky
+	}
+	if k <= 0 {
+		return nil
+	}
+	var dims []int
+	for dim, order := range prefs {
+		if order != types.Ignore {
+			dims = append(dims, dim)
+		}
+	}
+	if len(dims) == 2 && !hasCategorical(prefs) {
+		return representativeMaxDominance2D(sky, rest, dims, prefs, k)
+	}
+	return representativeMaxDominanceGreedy(sky, rest, prefs, k)
+}
+
+// representativeMaxDominance2D solves maximum coverage exactly on a 2D staircase. With the skyline
+// sorted by the first oriented dimension, the skyline points dominating a point p form a range:
+// a prefix is no worse than p in the first dimension and a suffix in the second.
+func representativeMaxDominance2D(sky, rest []types.Point, dims []int, prefs types.Preference, k int) []types.Point {
+	x := func(p types.Point) float64 { return orientedValue(p, dims[0], prefs[dims[0]]) }
+	y := func(p types.Point) float64 { return orientedValue(p, dims[1], prefs[dims[1]]) }
+	sort.Slice(sky, func(i, j int) bool {
+		if x(sky[i]) != x(sky[j]) {
+			return x(sky[i]) < x(sky[j])
+		}
+		return y(sky[i]) > y(sky[j])
+	})
+	n := len(sky)
+
+	ends := make([][]int, n)
+	for _, p := range rest {
+		hi := sort.Search(n, func(i int) bool { return x(sky[i]) > x(p) }) - 1
+		lo := sort.Search(n, func(i int) bool { return y(sky[i]) <= y(p) })
+		if lo <= hi {
+			ends[lo] = append(ends[lo], hi)
+		}
+	}
+	chosen := stabRanges(ends, k)
+	result := make([]types.Point, len(chosen))
+	for i, c := range chosen {
+		result[i] = sky[c]
+	}
+	return result
+}
+
+// stabRanges picks k of the positions 0..n-1 that together lie in the most ranges, where ends[l]
+// lists the ends of the ranges starting at l. opt[m][i] is the most ranges hit by m+1 positions,
+// the last of them i. Adding i after j gains the ranges that contain i and start after j, since a
+// range containing both an earlier position and i also contains j.
+func stabRanges(ends [][]int, k int) []int {
+	n := len(ends)
+	for _, e := range ends {
+		sort.Ints(e)
+	}
+	opt, prev := make([][]int, k), make([][]int, k)
+	for m := range opt {
+		opt[m], prev[m] = make([]int, n), make([]int, n)
+		for i := range opt[m] {
+			opt[m][i] = -1
+		}
+	}
+	for i := 0; i < n; i++ {
+		gain := 0
+		for j := i - 1; j >= 0; j-- {
+			gain += len(ends[j+1]) - sort.SearchInts(ends[j+1], i)
+			for m := 1; m < k; m++ {
+				if opt[m-1][j] >= 0 && opt[m-1][j]+gain > opt[m][i] {
+					opt[m][i], prev[m][i] = opt[m-1][j]+gain, j
+				}
+			}
+		}
+		opt[0][i] = gain + len(ends[0]) - sort.SearchInts(ends[0], i)
+	}
+
+	last := 0
+	for i := range opt[k-1] {
+		if opt[k-1][i] > opt[k-1][last] {
+			last = i
+		}
+	}
+	chosen := make([]int, 0, k)
+	for m, i := k-1, last; m >= 0; m-- {
+		chosen = append(chosen, i)
+		i = prev[m][i]
+	}
+	return chosen
+}
+
+// representativeMaxDominanceGreedy adds the skyline point dominating the most uncovered points
+// until k are chosen. Marginal gains only shrink as points are covered, so stale gains are kept
+// in a max-heap and only re-evaluated when they reach the top (lazy greedy).
+func representativeMaxDominanceGreedy(sky, rest []types.Point, prefs types.Preference, k int) []types.Point {
+	covered := make([]bool, len(rest))
+	gain := func(s types.Point) int {
+		n := 0
+		for i, p := range rest {
+			if !covered[i] && utilities.DominatesEpsilon(s, p, prefs, 0) {
+				n++
+			}
+		}
+		return n
+	}
+
+	h := make(gainHeap, len(sky))
+	for i, s := range sky {
+		h[i] = gainEntry{idx: i, gain: gain(s)}
+	}
+	heap.Init(&h)
+
+	result := make([]types.Point, 0, k)
+	round := 0
+	for len(result) < k && h.Len() > 0 {
+		top := h[0]
+		if top.round != round {
+			h[0].gain, h[0].round = gain(sky[top.idx]), round
+			heap.Fix(&h, 0)
+			continue
+		}
+		heap.Pop(&h)
+		s := sky[top.idx]
+		result = append(result, s)
+		for i, p := range rest {
+			if !covered[i] && utilities.DominatesEpsilon(s, p, prefs, 0) {
+				covered[i] = true
+			}
+		}
+		round++
+	}
+	return result
+}
+
+// RepresentativeDistance picks k skyline points minimizing the maximum distance from any skyline
+// point to its nearest representative (k-center on the skyline), using Euclidean distance over the
+// non-ignored dimensions. In two dimensions the skyline is a staircase and optimal clusters are
+// contiguous along it, so an exact dynamic program is used. In higher dimensions the greedy
+// farthest-point heuristic gives a 2-approximation.
+func RepresentativeDistance(data []types.Point, prefs types.Preference, k int) []types.Point {
+	sky, _ := splitSkyline(data, prefs)
+	if k >= len(sky) {
+		return sky
+	}
+	if k <= 0 {
+		return nil
+	}
+	var dims []int
+	for dim, order := range prefs {
+		if order != types.Ignore {
+			dims = append(dims, dim)
+		}
+	}
+	if len(dims) == 2 {
+		return representativeDistance2D(sky, dims, k)
+	}
+	return representativeDistanceGreedy(sky, dims, k)
+}
+
+// splitSkyline separates the skyline of data from the dominated points.
+func splitSkyline(data []types.Point, prefs types.Preference) ([]types.Point, []types.Point) {
+	var sky, rest []types.Point
+	for i, rank := range LayerRanks(data, prefs, 1) {
+		if rank == 1 {
+			sky = append(sky, data[i])
+		} else {
+			rest = append(rest, data[i])
+		}
+	}
+	return sky, rest
+}
+
+func distance(a, b types.Point, dims []int) float64 {
+	sum := 0.0
+	for _, d := range dims {
+		diff := a[d] - b[d]
+		sum += diff * diff
+	}
+	return math.Sqrt(sum)
+}
+
+// representativeDistance2D solves k-center exactly on a 2D staircase.
+// opt[m][j] is the best radius covering sky[0..j] with m+1 contiguous clusters. Along the staircase
+// distances grow monotonically away from any point, so the best center of a cluster and the best
+// split point before the last cluster can both be found by binary search.
+func representativeDistance2D(sky []types.Point, dims []int, k int) []types.Point {
+	sort.Slice(sky, func(i, j int) bool { return sky[i][dims[0]] < sky[j][dims[0]] })
+	n := len(sky)
+
+	// cover returns the best center for sky[i..j] and its radius
+	cover := func(i, j int) (int, float64) {
+		c := i + sort.Search(j-i+1, func(c int) bool {
+			return distance(sky[i+c], sky[i], dims) >= distance(sky[i+c], sky[j], dims)
+		})
+		best, radius := c, math.Inf(1)
+		for _, cand := range []int{c - 1, c} {
+			if cand < i || cand > j {
+				continue
+			}
+			r := math.Max(distance(sky[cand], sky[i], dims), distance(sky[cand], sky[j], dims))
+			if r < radius {
+				best, radius = cand, r
+			}
+		}
+		return best, radius
+	}
+
+	opt := make([][]float64, k)
+	split := make([][]int, k)
+	for m := range opt {
+		opt[m] = make([]float64, n)
+		split[m] = make([]int, n)
+		for j := 0; j < n; j++ {
+			if m == 0 {
+				_, opt[m][j] = cover(0, j)
+				continue
+			}
+			prev := func(i int) float64 {
+				if i == 0 {
+					return 0
+				}
+				return opt[m-1][i-1]
+			}
+			// prev is nondecreasing and the last cluster's radius nonincreasing in the split i
+			i := sort.Search(j+1, func(i int) bool {
+				_, r := cover(i, j)
+				return prev(i) >= r
+			})
+			opt[m][j] = math.Inf(1)
+			for _, cand := range []int{i - 1, i} {
+				if cand < 0 || cand > j {
+					continue
+				}
+				_, r := cover(cand, j)
+				if v := math.Max(prev(cand), r); v < opt[m][j] {
+					opt[m][j], split[m][j] = v, cand
+				}
+			}
+		}
+	}
+
+	result := make([]types.Point, 0, k)
+	for m, j := k-1, n-1; m >= 0 && j >= 0; m-- {
+		i := 0
+		if m > 0 {
+			i = split[m][j]
+		}
+		c, _ := cover(i, j)
+		result = append(result, sky[c])
+		j = i - 1
+	}
+	return result
+}
+
+// representativeDistanceGreedy repeatedly adds the skyline point farthest from its nearest representative.
+func representativeDistanceGreedy(sky []types.Point, dims []int, k int) []types.Point {
+	nearest := make([]float64, len(sky))
+	for i := range nearest {
+		nearest[i] = math.Inf(1)
+	}
+	result := make([]types.Point, 0, k)
+	next := 0
+	for len(result) < k {
+		c := sky[next]
+		result = append(result, c)
+		far := 0.0
+		for i, p := range sky {
+			if d := distance(p, c, dims); d < nearest[i] {
+				nearest[i] = d
+			}
+			if nearest[i] > far {
+				far, next = nearest[i], i
+			}
+		}
+		if far == 0 {
+			break
+		}
+	}
+	return result
+}
+
+type gainEntry struct {
+	idx   int
+	gain  int
+	round int
+}
+
+// gainHeap is a max-heap of marginal gains.
+type gainHeap []gainEntry
+
+func (h gainHeap) Len() int            { return len(h) }
+func (h gainHeap) Less(i, j int) bool  { return h[i].gain > h[j].gain }
+func (h gainHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *gainHeap) Push(x interface{}) { *h = append(*h, x.(gainEntry)) }
+func (h *gainHeap) Pop() interface{} {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
@@ -0,0 +1,160 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// coverRadius is the largest distance from a skyline point to its nearest representative
+func coverRadius(sky, reps []types.Point, dims []int) float64 {
+	radius := 0.0
+	for _, p := range sky {
+		nearest := math.Inf(1)
+		for _, r := range reps {
+			nearest = math.Min(nearest, distance(p, r, dims))
+		}
+		radius = math.Max(radius, nearest)
+	}
+	return radius
+}
+
+// bestRadius enumerates every k-subset of the skyline
+func bestRadius(sky []types.Point, dims []int, k int) float64 {
+	best := math.Inf(1)
+	var rec func(start int, chosen []types.Point)
+	rec = func(start int, chosen []types.Point) {
+		if len(chosen) == k {
+			best = math.Min(best, coverRadius(sky, chosen, dims))
+			return
+		}
+		for i := start; i < len(sky); i++ {
+			rec(i+1, append(chosen, sky[i]))
+		}
+	}
+	rec(0, nil)
+	return best
+}
+
+func TestRepresentativeDistance2D(t *testing.T) {
+	// An irregular 2D front: x increases while y decreases
+	var data types.Dataset
+	xs := []float64{0, 1, 1.5, 4, 4.2, 7, 9, 9.5, 13, 20}
+	for i, x := range xs {
+		data = append(data, types.Point{x, 30 - x*x/15 - float64(i%3)})
+		data = append(data, types.Point{x + 1, 40}) // dominated
+	}
+	prefs := types.Preference{types.Min, types.Min}
+	sky, _ := splitSkyline(data, prefs)
+	dims := []int{0, 1}
+	for k := 1; k <= 5; k++ {
+		reps := RepresentativeDistance(data, prefs, k)
+		if len(reps) > k {
+			t.Fatalf("k=%d: got %d representatives", k, len(reps))
+		}
+		got, want := coverRadius(sky, reps, dims), bestRadius(sky, dims, k)
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("k=%d: radius %v, optimal %v", k, got, want)
+		}
+	}
+}
+
+func TestRepresentativeDistanceGreedy(t *testing.T) {
+	var data types.Dataset
+	for i := 0; i < 12; i++ {
+		x := float64(i)
+		data = append(data, types.Point{x, 11 - x, float64((i * 5) % 12)})
+	}
+	prefs := types.Preference{types.Min, types.Min, types.Max}
+	sky, _ := splitSkyline(data, prefs)
+	dims := []int{0, 1, 2}
+	for k := 1; k <= 4; k++ {
+		reps := RepresentativeDistance(data, prefs, k)
+		got, opt := coverRadius(sky, reps, dims), bestRadius(sky, dims, k)
+		if got > 2*opt+1e-9 {
+			t.Errorf("k=%d: greedy radius %v exceeds twice the optimum %v", k, got, opt)
+		}
+	}
+}
+
+func TestRepresentativeMaxDominance(t *testing.T) {
+	data := types.Dataset{
+		{1, 10}, {5, 5}, {10, 1}, // skyline
+		{6, 6}, {7, 7}, {8, 6}, {6, 8}, // dominated by {5,5}
+		{2, 11}, // dominated by {1,10}
+		{11, 2}, // dominated by {10,1}
+	}
+	prefs := types.Preference{types.Min, types.Min}
+	reps := RepresentativeMaxDominance(data, prefs, 1)
+	if len(reps) != 1 || !isPointEqual(reps[0], types.Point{5, 5}) {
+		t.Errorf("expected {5,5} as the single most dominating representative, got %v", reps)
+	}
+	reps = RepresentativeMaxDominance(data, prefs, 2)
+	covered := 0
+	for _, p := range data {
+		for _, r := range reps {
+			if utilities.DominatesEpsilon(r, p, prefs, 0) {
+				covered++
+				break
+			}
+		}
+	}
+	if covered != 5 {
+		t.Errorf("expected two representatives to dominate 5 points, got %d with %v", covered, reps)
+	}
+	if len(RepresentativeMaxDominance(data, prefs, 5)) != 3 {
+		t.Errorf("k above the skyline size should return the whole skyline")
+	}
+}
+
+// coveredBy counts the points of data dominated by at least one of reps.
+func coveredBy(data, reps []types.Point, prefs types.Preference) int {
+	covered := 0
+	for _, p := range data {
+		for _, r := range reps {
+			if utilities.DominatesEpsilon(r, p, prefs, 0) {
+				covered++
+				break
+			}
+		}
+	}
+	return covered
+}
+
+func TestRepresentativeMaxDominance2DIsOptimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(38))
+	prefs := types.Preference{types.Min, types.Max}
+	for trial := 0; trial < 30; trial++ {
+		data := make([]types.Point, 60)
+		for i := range data {
+			data[i] = types.Point{float64(rng.Intn(30)), float64(rng.Intn(30))}
+		}
+		sky, _ := splitSkyline(data, prefs)
+		for k := 1; k < len(sky) && k <= 3; k++ {
+			// Exhaustive search over every k-subset of the skyline
+			best := 0
+			var search func(start int, reps []types.Point)
+			search = func(start int, reps []types.Point) {
+				if len(reps) == k {
+					best = max(best, coveredBy(data, reps, prefs))
+					return
+				}
+				for i := start; i < len(sky); i++ {
+					search(i+1, append(reps, sky[i]))
+				}
+			}
+			search(0, nil)
+
+			reps := RepresentativeMaxDominance(data, prefs, k)
+			if len(reps) != k {
+				t.Fatalf("expected %d representatives, got %v", k, reps)
+			}
+			if got := coveredBy(data, reps, prefs); got != best {
+				t.Errorf("trial %d, k=%d: representatives dominate %d points, optimum is %d", trial, k, got, best)
+			}
+		}
+	}
+}
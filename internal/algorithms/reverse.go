@@ -0,0 +1,86 @@
+package algorithms
+
+import (
+	"math"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// ReverseSkyline returns the points whose dynamic skyline contains q, i.e. the points p for
+// which no other point is at least as close to p as q in every non-ignored dimension and
+// strictly closer in one.
+//
+// Only the global skyline of q can qualify: if p lies between q and p' in every dimension then
+// p is closer to p' than q is. Each global skyline point c is then verified with a window
+// query, the box centered at c whose half-widths are |q - c| (the midpoint window), which must
+// contain no other point that is strictly closer somewhere.
+func ReverseSkyline(data []types.Point, prefs types.Preference, q types.Point) []types.Point {
+	var result []types.Point
+	for _, c := range globalSkyline(data, prefs, q) {
+		if !windowOccupied(data, prefs, c, q) {
+			result = append(result, data[c])
+		}
+	}
+	return result
+}
+
+// globalSkyline returns the indices of the points not globally dominated with respect to q,
+// scanning in order of increasing distance to q.
+func globalSkyline(data []types.Point, prefs types.Preference, q types.Point) []int {
+	t := types.QueryTransform{Query: q}
+	dist := MinimizeAll(prefs)
+	order := monotoneOrderFunc(len(data), dist, func(i, dim int, o types.Order) float64 {
+		return orientedTransformValue(data[i], dim, o, t)
+	})
+	var window []int
+	for _, i := range order {
+		dominated := false
+		for _, w := range window {
+			if globallyDominates(data[w], data[i], prefs, q) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			window = append(window, i)
+		}
+	}
+	return window
+}
+
+// globallyDominates returns true if a lies in the same orthant around q as b, is at least as
+// close to q in every dimension and strictly closer in one. A point equal to q globally
+// dominates nothing, since it is never strictly closer to anything than q itself.
+func globallyDominates(a, b types.Point, prefs types.Preference, q types.Point) bool {
+	anyBetter, differsFromQ := false, false
+	for dim, order := range prefs {
+		if order == types.Ignore {
+			continue
+		}
+		da, db := a[dim]-q[dim], b[dim]-q[dim]
+		if da*db < 0 || math.Abs(da) > math.Abs(db) {
+			return false
+		}
+		if math.Abs(da) < math.Abs(db) {
+			anyBetter = true
+		}
+		if da != 0 {
+			differsFromQ = true
+		}
+	}
+	return anyBetter && differsFromQ
+}
+
+// windowOccupied reports whether some point other than data[c] dominates q in the dynamic
+// space of data[c].
+func windowOccupied(data []types.Point, prefs types.Preference, c int, q types.Point) bool {
+	t := types.QueryTransform{Query: data[c]}
+	dist := MinimizeAll(prefs)
+	for i, x := range data {
+		if i != c && utilities.DominatesTransform(x, q, dist, t, 0) {
+			return true
+		}
+	}
+	return false
+}
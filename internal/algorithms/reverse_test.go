@@ -0,0 +1,53 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bruteForceReverseSkyline checks, for every point, whether q survives in its dynamic skyline
+func bruteForceReverseSkyline(data types.Dataset, prefs types.Preference, q types.Point) types.Dataset {
+	var result types.Dataset
+	for i, p := range data {
+		t := types.QueryTransform{Query: p}
+		inSkyline := true
+		for j, x := range data {
+			if i != j && utilities.DominatesTransform(x, q, MinimizeAll(prefs), t, 0) {
+				inSkyline = false
+				break
+			}
+		}
+		if inSkyline {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func TestReverseSkyline(t *testing.T) {
+	data := make(types.Dataset, 0, 200)
+	for i := 0; i < 200; i++ {
+		data = append(data, types.Point{float64((i * 37) % 53), float64((i * 11) % 47), float64(i % 7)})
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Ignore}
+	for _, q := range []types.Point{{25, 20, 0}, {0, 0, 0}, {60, 50, 3}, data[10]} {
+		result := ReverseSkyline(data, prefs, q)
+		expected := bruteForceReverseSkyline(data, prefs, q)
+		if !equalSkylineSet(result, expected) {
+			t.Errorf("q=%v: got %v, want %v", q, result, expected)
+		}
+	}
+}
+
+func TestReverseSkyline_Small(t *testing.T) {
+	data := types.Dataset{{2, 2}, {8, 8}, {5, 1}}
+	q := types.Point{4, 4}
+	// {2,2}: {5,1} is 3 and 1 away vs q at 2 and 2, so q survives. {8,8}: q is closest.
+	// {5,1}: {2,2} is 3 and 1 away vs q at 1 and 3, so q survives.
+	result := ReverseSkyline(data, types.Preference{types.Min, types.Min}, q)
+	if !equalSkylineSet(result, data) {
+		t.Errorf("expected every point in the reverse skyline, got %v", result)
+	}
+}
@@ -0,0 +1,37 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// SFS computes the skyline using Sort-Filter-Skyline: points are presorted in dominance-monotone
+// order (see monotoneOrder), so a point can only be dominated by points already in the window and
+// window points never need to be evicted. Categorical dimensions are ordered by their depth in the
+// partial order. With a non-zero cfg.Epsilon the presort is no longer monotone and the window is
+// checked in both directions as in BNL.
+func SFS[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, cfg BNLConfig) S {
+	epsilon := T(cfg.Epsilon)
+	var skyline S
+	for _, idx := range monotoneOrder(data, prefs) {
+		p := data[idx]
+		if !utilities.Satisfies(p, cfg.Constraints) {
+			continue
+		}
+		dominated := false
+		for i := 0; i < len(skyline); {
+			if utilities.DominatesEpsilon(skyline[i], p, prefs, epsilon) {
+				dominated = true
+				break
+			} else if epsilon != 0 && utilities.DominatesEpsilon(p, skyline[i], prefs, epsilon) {
+				skyline = append(skyline[:i], skyline[i+1:]...)
+			} else {
+				i++
+			}
+		}
+		if !dominated {
+			skyline = append(skyline, p)
+		}
+	}
+	return skyline
+}
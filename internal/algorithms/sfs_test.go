@@ -0,0 +1,77 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestSFS_Skyline(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    types.Dataset
+		expected types.Dataset
+		prefs    types.Preference
+	}{
+		{"5SomeDominating", Dataset5SomeDominating, ExpectedSkyline5SomeDominating, types.Preference{types.Min, types.Max}},
+		{"Empty", DatasetEmpty, ExpectedSkylineEmpty, types.Preference{types.Min, types.Max}},
+		{"AllSame", DatasetAllSame, ExpectedSkylineAllSame, types.Preference{types.Min, types.Max}},
+		{"5000CoupleDominating", Dataset5000CoupleDominating, ExpectedSkyline5000CoupleDominating, types.Preference{types.Min, types.Max}},
+		{"1000CoupleDominating4D", Dataset1000CoupleDominating4D, ExpectedSkyline1000CoupleDominating4D, types.Preference{types.Min, types.Min, types.Min, types.Min}},
+		{"2000AllSkyline8D", Dataset2000AllSkyline8D, ExpectedSkyline2000AllSkyline8D, types.Preference{types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min, types.Min}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := SFS(tc.input, tc.prefs, BNLConfig{})
+			if !equalSkylineSet(result, tc.expected) {
+				t.Errorf("SFS skyline incorrect for %s: got %v, want %v", tc.name, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSFS_CategoricalMatchesBNL(t *testing.T) {
+	// airline: 0 > 1, 0 > 2, 1 > 3, 2 > 3; 4 is incomparable to all
+	po, err := types.NewPartialOrder(5, [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	prefs := types.Preference{types.Min, types.Categorical(po), types.Max}
+
+	rng := rand.New(rand.NewSource(5))
+	data := make(types.Dataset, 2000)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(100)), float64(rng.Intn(5)), float64(rng.Intn(10))}
+	}
+	bnl := BNL(data, prefs, BNLConfig{})
+	sfs := SFS(data, prefs, BNLConfig{})
+	if !equalSkylineSet(sfs, bnl) {
+		t.Errorf("SFS and BNL disagree: %d vs %d points", len(sfs), len(bnl))
+	}
+	dnc := DivideAndConquer(data, prefs, &types.DNCConfig{Threshold: 50, BatchSize: 50})
+	if !equalSkylineSet(dnc, bnl) {
+		t.Errorf("D&C and BNL disagree: %d vs %d points", len(dnc), len(bnl))
+	}
+	cfg := DefaultSkyTreeConfig
+	cfg.BNLSwitchThreshold = 50
+	if tree := SkyTree(data, prefs, cfg); !equalSkylineSet(tree, bnl) {
+		t.Errorf("SkyTree and BNL disagree: %d vs %d points", len(tree), len(bnl))
+	}
+}
+
+func TestSFS_Categorical(t *testing.T) {
+	po, _ := types.NewPartialOrder(3, [][2]int{{0, 1}, {0, 2}})
+	prefs := types.Preference{types.Min, types.Categorical(po)}
+	data := types.Dataset{
+		{100, 0}, // preferred category, expensive
+		{100, 1}, // dominated by {100, 0}
+		{80, 1},  // cheaper
+		{80, 2},  // incomparable to {80, 1}
+		{90, 2},  // dominated by {80, 2}
+	}
+	expected := types.Dataset{{100, 0}, {80, 1}, {80, 2}}
+	if got := SFS(data, prefs, BNLConfig{}); !equalSkylineSet(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,45 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// KSkyband returns all points that are dominated by fewer than k other points.
+// The 1-skyband is the skyline. Points are returned in dominance-monotone order.
+func KSkyband[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, k int) S {
+	band, _ := kSkybandIndices(data, prefs, k)
+	result := make(S, len(band))
+	for i, idx := range band {
+		result[i] = data[idx]
+	}
+	return result
+}
+
+// kSkybandIndices computes the k-skyband in a single pass with a counting window.
+// Points are visited in monotone order, so every dominator of a point has already been seen.
+// A rejected point has at least k dominators, all of which also dominate whatever it dominates,
+// so counting only against accepted points is exact. It returns the indices of the band and,
+// for each of them, the exact number of points in data that dominate it.
+func kSkybandIndices[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, k int) ([]int, []int) {
+	if k <= 0 {
+		return nil, nil
+	}
+	var band, counts []int
+	for _, i := range monotoneOrder(data, prefs) {
+		count := 0
+		for _, j := range band {
+			if utilities.DominatesEpsilon(data[j], data[i], prefs, 0) {
+				count++
+				if count >= k {
+					break
+				}
+			}
+		}
+		if count < k {
+			band = append(band, i)
+			counts = append(counts, count)
+		}
+	}
+	return band, counts
+}
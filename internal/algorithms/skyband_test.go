@@ -0,0 +1,62 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bruteForceSkyband counts dominators for every point
+func bruteForceSkyband(data types.Dataset, prefs types.Preference, k int) types.Dataset {
+	var result types.Dataset
+	for i, p := range data {
+		count := 0
+		for j, q := range data {
+			if i != j && utilities.DominatesEpsilon(q, p, prefs, 0) {
+				count++
+			}
+		}
+		if count < k {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func TestKSkyband(t *testing.T) {
+	data := types.Dataset{
+		{1, 9}, {2, 8}, {3, 3}, {4, 6}, {5, 5}, {6, 7}, {7, 2}, {8, 1}, {2, 8}, {9, 9}, {4, 4},
+	}
+	prefs := types.Preference{types.Min, types.Min}
+	for k := 0; k <= 5; k++ {
+		result := KSkyband(data, prefs, k)
+		expected := bruteForceSkyband(data, prefs, k)
+		if !equalSkylineSet(result, expected) {
+			t.Errorf("KSkyband k=%d incorrect: got %v, want %v", k, result, expected)
+		}
+	}
+}
+
+func TestKSkyband_OneIsSkyline(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min}
+	result := KSkyband(Dataset1000CoupleDominating4D, prefs, 1)
+	if !equalSkylineSet(result, ExpectedSkyline1000CoupleDominating4D) {
+		t.Errorf("1-skyband should equal the skyline: got %v, want %v", result, ExpectedSkyline1000CoupleDominating4D)
+	}
+}
+
+func TestKSkyband_Counts(t *testing.T) {
+	data := types.Dataset{{3, 3}, {1, 1}, {2, 2}, {0, 5}}
+	prefs := types.Preference{types.Min, types.Min}
+	band, counts := kSkybandIndices(data, prefs, 3)
+	want := map[int]int{1: 0, 3: 0, 2: 1, 0: 2}
+	if len(band) != len(want) {
+		t.Fatalf("expected %d points in band, got %v", len(want), band)
+	}
+	for i, idx := range band {
+		if counts[i] != want[idx] {
+			t.Errorf("point %v: expected %d dominators, got %d", data[idx], want[idx], counts[i])
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package algorithms
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// MaxSkyCubeDims bounds the number of non-ignored dimensions a skycube can be built for,
+// since the cube has 2^d - 1 cuboids.
+const MaxSkyCubeDims = 20
+
+// SkyCube computes the skyline of every non-empty subspace of the non-ignored dimensions,
+// as indices into data keyed by subspace.
+//
+// The lattice is traversed top-down. Each subspace is computed from the extended skyline of one
+// parent (points not strictly beaten in every dimension), which contains the skyline and the
+// extended skyline of every child subspace even when values tie, so no subspace rescans the
+// dataset. Cuboids equal to their parent's share the parent's slice to keep memory down.
+func SkyCube(data []types.Point, prefs types.Preference) (map[types.Subspace][]int32, error) {
+	full, d, err := skyCubeSpace(prefs)
+	if err != nil {
+		return nil, err
+	}
+	cube := make(map[types.Subspace][]int32)
+	if d == 0 {
+		return cube, nil
+	}
+
+	ext := map[types.Subspace][]int32{full: extendedSkylineIndices(data, allIndices32(len(data)), prefs)}
+	cube[full] = skylineIndices(data, ext[full], prefs)
+
+	levels := subspacesByLevel(full, d)
+	for level := d - 1; level >= 1; level-- {
+		next := make(map[types.Subspace][]int32, len(levels[level]))
+		for _, m := range levels[level] {
+			free := full &^ m
+			parent := m | (free & -free)
+			sub := restrictPrefs(prefs, m)
+			next[m] = extendedSkylineIndices(data, ext[parent], sub)
+			sky := skylineIndices(data, next[m], sub)
+			if equalIndices(sky, cube[parent]) {
+				sky = cube[parent]
+			}
+			cube[m] = sky
+		}
+		ext = next
+	}
+	return cube, nil
+}
+
+// CompressedSkyCube computes the compressed skycube of data (Xia and Zhang, 2006): every point is
+// stored only in its minimal subspaces, those whose skyline contains it while the skyline of no
+// proper subspace does. The result is keyed like SkyCube, but its size is the number of (point,
+// minimal subspace) pairs instead of the total size of all skylines.
+//
+// Subspaces are visited bottom-up and each skyline is computed from the extended skyline of the
+// full space, which contains every subspace skyline, so only the compressed cuboids are kept.
+// A point is added to a subspace unless one of its minimal subspaces found so far is contained in it.
+func CompressedSkyCube(data []types.Point, prefs types.Preference) (map[types.Subspace][]int32, error) {
+	full, d, err := skyCubeSpace(prefs)
+	if err != nil {
+		return nil, err
+	}
+	cube := make(map[types.Subspace][]int32)
+	if d == 0 {
+		return cube, nil
+	}
+
+	ext := extendedSkylineIndices(data, allIndices32(len(data)), prefs)
+	minimal := make(map[int32][]types.Subspace)
+	levels := subspacesByLevel(full, d)
+	for level := 1; level <= d; level++ {
+		for _, m := range levels[level] {
+			for _, p := range skylineIndices(data, ext, restrictPrefs(prefs, m)) {
+				if !containsSubspaceOf(minimal[p], m) {
+					minimal[p] = append(minimal[p], m)
+					cube[m] = append(cube[m], p)
+				}
+			}
+		}
+	}
+	return cube, nil
+}
+
+// CompressedSkyline returns the skyline of subspace s from a compressed skycube of data, or nil if
+// s is empty or includes ignored dimensions. Every skyline point of s has a minimal subspace inside
+// s, so the points stored in the subspaces of s contain the skyline, and one skyline pass over
+// them removes the rest.
+func CompressedSkyline(data []types.Point, prefs types.Preference, cube map[types.Subspace][]int32, s types.Subspace) []int32 {
+	if s == 0 || s&^nonIgnored(prefs) != 0 {
+		return nil
+	}
+	var candidates []int32
+	seen := make(map[int32]bool)
+	for m := s; m > 0; m = (m - 1) & s {
+		for _, p := range cube[m] {
+			if !seen[p] {
+				seen[p] = true
+				candidates = append(candidates, p)
+			}
+		}
+	}
+	return skylineIndices(data, candidates, restrictPrefs(prefs, s))
+}
+
+// skyCubeSpace returns the subspace of the non-ignored dimensions of prefs and its size, which
+// must not exceed MaxSkyCubeDims.
+func skyCubeSpace(prefs types.Preference) (types.Subspace, int, error) {
+	full := nonIgnored(prefs)
+	d := bits.OnesCount64(uint64(full))
+	if d > MaxSkyCubeDims {
+		return 0, 0, fmt.Errorf("skycube supports at most %d dimensions, got %d", MaxSkyCubeDims, d)
+	}
+	return full, d, nil
+}
+
+func nonIgnored(prefs types.Preference) types.Subspace {
+	var full types.Subspace
+	for dim, order := range prefs {
+		if order != types.Ignore {
+			full |= types.SubspaceOf(dim)
+		}
+	}
+	return full
+}
+
+// containsSubspaceOf reports whether one of subspaces is contained in s.
+func containsSubspaceOf(subspaces []types.Subspace, s types.Subspace) bool {
+	for _, m := range subspaces {
+		if m&s == m {
+			return true
+		}
+	}
+	return false
+}
+
+func allIndices32(n int) []int32 {
+	idx := make([]int32, n)
+	for i := range idx {
+		idx[i] = int32(i)
+	}
+	return idx
+}
+
+// subspacesByLevel groups the non-empty subsets of full by their number of dimensions.
+func subspacesByLevel(full types.Subspace, d int) [][]types.Subspace {
+	levels := make([][]types.Subspace, d+1)
+	for m := full; m > 0; m = (m - 1) & full {
+		n := bits.OnesCount64(uint64(m))
+		levels[n] = append(levels[n], m)
+	}
+	return levels
+}
+
+// restrictPrefs returns prefs with every dimension outside s set to Ignore.
+func restrictPrefs(prefs types.Preference, s types.Subspace) types.Preference {
+	out := make(types.Preference, len(prefs))
+	for dim, order := range prefs {
+		if s&types.SubspaceOf(dim) == 0 {
+			order = types.Ignore
+		}
+		out[dim] = order
+	}
+	return out
+}
+
+// extendedSkylineIndices keeps the points of idx that no other point strictly beats in every dimension.
+func extendedSkylineIndices(data []types.Point, idx []int32, prefs types.Preference) []int32 {
+	return windowIndices(data, idx, func(a, b types.Point) bool { return utilities.DominatesStrict(a, b, prefs) })
+}
+
+// skylineIndices keeps the points of idx that no other point of idx dominates.
+func skylineIndices(data []types.Point, idx []int32, prefs types.Preference) []int32 {
+	return windowIndices(data, idx, func(a, b types.Point) bool { return utilities.DominatesEpsilon(a, b, prefs, 0) })
+}
+
+// windowIndices is BNL over a subset of data for any transitive dominance relation.
+func windowIndices(data []types.Point, idx []int32, dominates func(a, b types.Point) bool) []int32 {
+	var window []int32
+	for _, p := range idx {
+		dominated := false
+		for i := 0; i < len(window); {
+			if dominates(data[window[i]], data[p]) {
+				dominated = true
+				break
+			} else if dominates(data[p], data[window[i]]) {
+				window = append(window[:i], window[i+1:]...)
+			} else {
+				i++
+			}
+		}
+		if !dominated {
+			window = append(window, p)
+		}
+	}
+	return window
+}
+
+func equalIndices(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
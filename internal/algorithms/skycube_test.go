@@ -0,0 +1,112 @@
+package algorithms
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestSkyCube(t *testing.T) {
+	// Small value domains produce many ties, where subspace skylines are not subsets of the full skyline
+	data := make(types.Dataset, 0, 400)
+	for i := 0; i < 400; i++ {
+		data = append(data, types.Point{float64((i * 7) % 5), float64((i * 3) % 4), float64(i % 6), 0, float64((i * 11) % 7)})
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Min, types.Ignore, types.Max}
+
+	cube, err := SkyCube(data, prefs)
+	if err != nil {
+		t.Fatalf("SkyCube failed: %v", err)
+	}
+	if len(cube) != 15 {
+		t.Fatalf("expected 2^4-1 = 15 cuboids, got %d", len(cube))
+	}
+	for s, idx := range cube {
+		if s&types.SubspaceOf(3) != 0 {
+			t.Errorf("cuboid %b includes the ignored dimension", s)
+		}
+		result := make(types.Dataset, len(idx))
+		for i, j := range idx {
+			result[i] = data[j]
+		}
+		expected := BlockNestedLoop(data, restrictPrefs(prefs, s))
+		if !equalSkylineSet(result, expected) {
+			t.Errorf("subspace %b: got %d points, want %d", s, len(result), len(expected))
+		}
+	}
+}
+
+func TestCompressedSkyCube(t *testing.T) {
+	// Ties make subspace skylines differ from the full skyline, as in TestSkyCube
+	data := make(types.Dataset, 0, 400)
+	for i := 0; i < 400; i++ {
+		data = append(data, types.Point{float64((i * 7) % 5), float64((i * 3) % 4), float64(i % 6), 0, float64((i * 11) % 7)})
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Min, types.Ignore, types.Max}
+
+	cube, err := SkyCube(data, prefs)
+	if err != nil {
+		t.Fatalf("SkyCube failed: %v", err)
+	}
+	compressed, err := CompressedSkyCube(data, prefs)
+	if err != nil {
+		t.Fatalf("CompressedSkyCube failed: %v", err)
+	}
+	full, stored := 0, 0
+	for s, idx := range cube {
+		full += len(idx)
+		if got := CompressedSkyline(data, prefs, compressed, s); !equalSkylineSet(pointsAt(data, got), pointsAt(data, idx)) {
+			t.Errorf("subspace %b: got %d points, want %d", s, len(got), len(idx))
+		}
+	}
+	for _, idx := range compressed {
+		stored += len(idx)
+	}
+	if stored >= full {
+		t.Errorf("expected the compressed cube to store fewer entries than %d, got %d", full, stored)
+	}
+	for i := range data {
+		if got, want := MinimalSubspaces(compressed, i), MinimalSubspaces(cube, i); !slices.Equal(got, want) {
+			t.Errorf("point %d: minimal subspaces %v, want %v", i, got, want)
+		}
+	}
+	if CompressedSkyline(data, prefs, compressed, types.SubspaceOf(3)) != nil {
+		t.Errorf("expected nil for a subspace with an ignored dimension")
+	}
+}
+
+func pointsAt(data types.Dataset, idx []int32) types.Dataset {
+	result := make(types.Dataset, len(idx))
+	for i, j := range idx {
+		result[i] = data[j]
+	}
+	return result
+}
+
+func TestSkyCube_Categorical(t *testing.T) {
+	po, err := types.NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := types.Dataset{{1, 1}, {2, 0}}
+	prefs := types.Preference{types.Min, types.Categorical(po)}
+
+	cube, err := SkyCube(data, prefs)
+	if err != nil {
+		t.Fatalf("SkyCube failed: %v", err)
+	}
+	if got := cube[types.SubspaceOf(0, 1)]; len(got) != 2 {
+		t.Errorf("full cuboid: expected both points, got %v", got)
+	}
+	if got := cube[types.SubspaceOf(1)]; len(got) != 1 || got[0] != 1 {
+		t.Errorf("categorical cuboid: expected [1], got %v", got)
+	}
+}
+
+func TestSkyCube_TooManyDims(t *testing.T) {
+	prefs := make(types.Preference, MaxSkyCubeDims+1)
+	if _, err := SkyCube(types.Dataset{make(types.Point, MaxSkyCubeDims+1)}, prefs); err == nil {
+		t.Errorf("expected an error above %d dimensions", MaxSkyCubeDims)
+	}
+}
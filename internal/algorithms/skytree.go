@@ -1,10 +1,10 @@
 package algorithms
 
 import (
-	"sort"
+	"slices"
 
-	"github.com/gkoos/skyline/internal/utilities"
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // DefaultSkyTreeConfig provides a default config for tests and static.go
@@ -18,18 +18,23 @@ var DefaultSkyTreeConfig = types.SkyTreeConfig{
 
 // SelectMedianPivot is a classic median pivot selector for static.go and tests
 func SelectMedianPivot(data types.Dataset, _ types.Preference) types.Point {
+	return selectMedianPivot(data)
+}
+
+// selectMedianPivot returns the point closest to the per-dimension medians.
+func selectMedianPivot[S ~[]types.PointOf[T], T types.Number](data S) types.PointOf[T] {
 	n := len(data)
 	if n == 0 {
 		return nil
 	}
 	dim := len(data[0])
-	medians := make([]float64, dim)
+	medians := make([]T, dim)
 	for i := 0; i < dim; i++ {
-		vals := make([]float64, n)
+		vals := make([]T, n)
 		for j, pt := range data {
 			vals[j] = pt[i]
 		}
-		sort.Float64s(vals)
+		slices.Sort(vals)
 		if n%2 == 0 {
 			medians[i] = (vals[n/2-1] + vals[n/2]) / 2
 		} else {
@@ -39,13 +44,13 @@ func SelectMedianPivot(data types.Dataset, _ types.Preference) types.Point {
 	best := data[0]
 	bestDist := 0.0
 	for i := range best {
-		d := best[i] - medians[i]
+		d := float64(best[i]) - float64(medians[i])
 		bestDist += d * d
 	}
 	for _, pt := range data[1:] {
 		dist := 0.0
 		for i := range pt {
-			d := pt[i] - medians[i]
+			d := float64(pt[i]) - float64(medians[i])
 			dist += d * d
 		}
 		if dist < bestDist {
@@ -59,29 +64,37 @@ func SelectMedianPivot(data types.Dataset, _ types.Preference) types.Point {
 type SkyTreeConfig = types.SkyTreeConfig
 
 // SkyTree computes the skyline using the SkyTree algorithm with pivot selection.
-func SkyTree(data []types.Point, prefs types.Preference, cfg SkyTreeConfig) []types.Point {
+// cfg.PivotSelector is only consulted for float64 points; other coordinate types use median pivots.
+// For integer coordinates cfg.Epsilon is truncated.
+func SkyTree[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, cfg SkyTreeConfig) S {
 	// Base cases
 	n := len(data)
 	if n == 0 {
 		return nil
 	}
 	if n == 1 {
+		if !utilities.Satisfies(data[0], cfg.Constraints) {
+			return nil
+		}
 		return data
 	}
 	if n <= cfg.BNLSwitchThreshold {
-		return BNL(data, prefs, BNLConfig{Epsilon: cfg.Epsilon})
+		return BNL(data, prefs, BNLConfig{Epsilon: cfg.Epsilon, Constraints: cfg.Constraints})
 	}
 
 	// Select pivot using the configured selector
-	pivot := cfg.PivotSelector(data, prefs)
+	pivot := selectPivot(data, prefs, cfg)
 	if pivot == nil {
 		return nil
 	}
 
 	// Partition data into points equal to pivot and the rest
-	equalToPivot := make([]types.Point, 0, n)
-	remaining := make([]types.Point, 0, n)
+	equalToPivot := make(S, 0, n)
+	remaining := make(S, 0, n)
 	for _, pt := range data {
+		if !utilities.Satisfies(pt, cfg.Constraints) {
+			continue
+		}
 		if isPointEqual(pt, pivot) {
 			equalToPivot = append(equalToPivot, pt)
 		} else {
@@ -90,14 +103,14 @@ func SkyTree(data []types.Point, prefs types.Preference, cfg SkyTreeConfig) []ty
 	}
 
 	// Partition remaining points by region relative to pivot
-	partitions := make(map[int][]types.Point)
+	partitions := make(map[int]S)
 	for _, pt := range remaining {
 		mask := regionMaskBit(pt, pivot, prefs)
 		partitions[mask] = append(partitions[mask], pt)
 	}
 
 	// Recursively compute skylines for each partition
-	var merged [][]types.Point
+	var merged []S
 	for _, subset := range partitions {
 		if len(subset) == 0 {
 			continue
@@ -107,7 +120,7 @@ func SkyTree(data []types.Point, prefs types.Preference, cfg SkyTreeConfig) []ty
 	}
 
 	// Merge all child skylines and points equal to pivot
-	result := make([]types.Point, 0, n)
+	result := make(S, 0, n)
 	for _, sky := range merged {
 		result = append(result, sky...)
 	}
@@ -117,8 +130,19 @@ func SkyTree(data []types.Point, prefs types.Preference, cfg SkyTreeConfig) []ty
 	return blockNestedLoop(result, prefs)
 }
 
+// selectPivot applies cfg.PivotSelector to float64 data and falls back to the median pivot otherwise.
+func selectPivot[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference, cfg SkyTreeConfig) types.PointOf[T] {
+	if cfg.PivotSelector != nil {
+		if points, ok := any([]types.PointOf[T](data)).([]types.Point); ok {
+			pivot, _ := any(cfg.PivotSelector(points, prefs)).(types.PointOf[T])
+			return pivot
+		}
+	}
+	return selectMedianPivot(data)
+}
+
 // isPointEqual checks if two points are exactly equal
-func isPointEqual(a, b types.Point) bool {
+func isPointEqual[T types.Number](a, b types.PointOf[T]) bool {
 	if len(a) != len(b) {
 		return false
 	}
@@ -130,23 +154,20 @@ func isPointEqual(a, b types.Point) bool {
 	return true
 }
 
-// regionMaskBit encodes the region of pt relative to pivot as an integer bitmask
-func regionMaskBit(pt, pivot types.Point, prefs types.Preference) int {
+// regionMaskBit encodes the region of pt relative to pivot as an integer bitmask: bit i is set if
+// pt is better than pivot in dimension i. In a categorical dimension that means a preferred category.
+func regionMaskBit[T types.Number](pt, pivot types.PointOf[T], prefs types.Preference) int {
 	mask := 0
 	for i := range pt {
-		if pt[i] == pivot[i] {
-			continue // bit stays 0
-		}
-		if (prefs[i] == types.Min && pt[i] < pivot[i]) || (prefs[i] == types.Max && pt[i] > pivot[i]) {
+		if utilities.Compare(pt[i], pivot[i], prefs[i], 0) == utilities.Better {
 			mask |= 1 << i // set bit i
 		}
-		// else: bit stays 0
 	}
 	return mask
 }
 
 // blockNestedLoop is a local version for internal use
-func blockNestedLoop(data []types.Point, prefs types.Preference) []types.Point {
+func blockNestedLoop[S ~[]types.PointOf[T], T types.Number](data S, prefs types.Preference) S {
 	n := len(data)
 	if n == 0 {
 		return nil
@@ -169,7 +190,7 @@ func blockNestedLoop(data []types.Point, prefs types.Preference) []types.Point {
 			}
 		}
 	}
-	var result []types.Point
+	var result S
 	for i, ok := range window {
 		if ok {
 			result = append(result, data[i])
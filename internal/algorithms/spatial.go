@@ -0,0 +1,143 @@
+package algorithms
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// SpatialSkyline computes the spatial skyline of data with respect to the query locations: p
+// dominates p' if it is at least as close to every query and strictly closer to one, and at least
+// as good in every non-spatial dimension. The location of a point is (p[location[0]], p[location[1]]),
+// queries are (x, y) points, and prefs applies to the remaining dimensions; its entries for the
+// location dimensions are ignored.
+//
+// Being at least as close to a query is a half-plane condition, so a point that is at least as
+// close to every vertex of the convex hull of the queries is at least as close to all of them:
+// only hull vertices are compared (Sharifzadeh and Shahabi, 2006). Without non-spatial dimensions,
+// a point inside the hull can never be dominated and skips dominance checks entirely.
+func SpatialSkyline(data []types.Point, location [2]int, queries []types.Point, prefs types.Preference) []types.Point {
+	t := spatialTransform{hull: convexHull(queries), location: location}
+	var tprefs types.Preference
+	for range t.hull {
+		tprefs = append(tprefs, types.Min)
+	}
+	for dim, order := range prefs {
+		if dim != location[0] && dim != location[1] && order != types.Ignore {
+			t.attributes = append(t.attributes, dim)
+			tprefs = append(tprefs, order)
+		}
+	}
+	if len(t.attributes) > 0 {
+		return TransformedSkyline(data, tprefs, t, BNLConfig{})
+	}
+
+	var inside, outside []types.Point
+	for _, p := range data {
+		if insideHull(t.hull, p[location[0]], p[location[1]]) {
+			inside = append(inside, p)
+		} else {
+			outside = append(outside, p)
+		}
+	}
+	// Points outside the hull cannot dominate points inside it
+	result := inside
+	for _, p := range TransformedSkyline(outside, tprefs, t, BNLConfig{}) {
+		dominated := false
+		for _, q := range inside {
+			if utilities.DominatesTransform(q, p, tprefs, t, 0) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// spatialTransform maps a point to its squared distances to the hull vertices, which order
+// points like distances do, followed by its non-spatial attributes.
+type spatialTransform struct {
+	hull       []types.Point
+	location   [2]int
+	attributes []int
+}
+
+func (t spatialTransform) Value(p types.Point, dim int) float64 {
+	if dim >= len(t.hull) {
+		return p[t.attributes[dim-len(t.hull)]]
+	}
+	dx, dy := p[t.location[0]]-t.hull[dim][0], p[t.location[1]]-t.hull[dim][1]
+	return dx*dx + dy*dy
+}
+
+func (t spatialTransform) Dims() int {
+	return len(t.hull) + len(t.attributes)
+}
+
+// convexHull returns the vertices of the convex hull of points in counter-clockwise order using
+// Andrew's monotone chain. Collinear and duplicate points are dropped, so a hull may have one or
+// two vertices.
+func convexHull(points []types.Point) []types.Point {
+	pts := append([]types.Point(nil), points...)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i][0] != pts[j][0] {
+			return pts[i][0] < pts[j][0]
+		}
+		return pts[i][1] < pts[j][1]
+	})
+	pts = slices.CompactFunc(pts, func(a, b types.Point) bool {
+		return a[0] == b[0] && a[1] == b[1]
+	})
+	if len(pts) < 3 {
+		return pts
+	}
+
+	hull := make([]types.Point, 0, 2*len(pts))
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range pts {
+			for len(hull) >= start+2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		// The last point of each chain starts the other one
+		hull = hull[:len(hull)-1]
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+	return hull
+}
+
+// cross returns the z component of (a - o) x (b - o), positive for a counter-clockwise turn.
+func cross(o, a, b types.Point) float64 {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
+
+// insideHull reports whether (x, y) lies inside or on the boundary of a counter-clockwise hull.
+func insideHull(hull []types.Point, x, y float64) bool {
+	p := types.Point{x, y}
+	switch len(hull) {
+	case 0:
+		return false
+	case 1:
+		return hull[0][0] == x && hull[0][1] == y
+	case 2:
+		a, b := hull[0], hull[1]
+		return cross(a, b, p) == 0 &&
+			min(a[0], b[0]) <= x && x <= max(a[0], b[0]) &&
+			min(a[1], b[1]) <= y && y <= max(a[1], b[1])
+	}
+	for i := range hull {
+		if cross(hull[i], hull[(i+1)%len(hull)], p) < 0 {
+			return false
+		}
+	}
+	return true
+}
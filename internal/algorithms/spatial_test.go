@@ -0,0 +1,79 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// bruteForceSpatial compares distances to every query, not only hull vertices
+func bruteForceSpatial(data, queries []types.Point, prefs types.Preference) types.Dataset {
+	var tprefs types.Preference
+	toSpace := func(p types.Point) types.Point {
+		var out types.Point
+		for _, q := range queries {
+			out = append(out, math.Hypot(p[0]-q[0], p[1]-q[1]))
+		}
+		return append(out, p[2:]...)
+	}
+	for range queries {
+		tprefs = append(tprefs, types.Min)
+	}
+	tprefs = append(tprefs, prefs[2:]...)
+
+	var result types.Dataset
+	for _, p := range data {
+		dominated := false
+		for _, q := range data {
+			if utilities.DominatesEpsilon(toSpace(q), toSpace(p), tprefs, 0) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func TestSpatialSkylineMatchesAllQueries(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+	queries := make([]types.Point, 8)
+	for i := range queries {
+		queries[i] = types.Point{40 + float64(rng.Intn(20)), 40 + float64(rng.Intn(20))}
+	}
+	data := make([]types.Point, 400)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(100)), float64(rng.Intn(100)), float64(rng.Intn(5))}
+	}
+
+	spatialOnly := types.Preference{types.Ignore, types.Ignore, types.Ignore}
+	got := SpatialSkyline(data, [2]int{0, 1}, queries, spatialOnly)
+	if expected := bruteForceSpatial(data, queries, spatialOnly); !equalSkylineSet(got, expected) {
+		t.Errorf("spatial only: expected %d points, got %d", len(expected), len(got))
+	}
+
+	withRating := types.Preference{types.Ignore, types.Ignore, types.Max}
+	got = SpatialSkyline(data, [2]int{0, 1}, queries, withRating)
+	if expected := bruteForceSpatial(data, queries, withRating); !equalSkylineSet(got, expected) {
+		t.Errorf("with rating: expected %d points, got %d", len(expected), len(got))
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	points := []types.Point{{0, 0}, {2, 0}, {1, 1}, {2, 2}, {0, 2}, {1, 0}, {2, 2}}
+	hull := convexHull(points)
+	if !equalSkylineSet(hull, types.Dataset{{0, 0}, {2, 0}, {2, 2}, {0, 2}}) {
+		t.Errorf("expected the four corners, got %v", hull)
+	}
+	if hull := convexHull([]types.Point{{0, 0}, {1, 1}, {2, 2}}); len(hull) != 2 {
+		t.Errorf("expected 2 vertices for collinear points, got %v", hull)
+	}
+	if !insideHull(hull, 1, 1) || !insideHull(hull, 2, 1) || insideHull(hull, 3, 1) {
+		t.Errorf("unexpected inside test results for hull %v", hull)
+	}
+}
@@ -1,7 +1,7 @@
 package algorithms
 
 import (
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // Deterministic datasets for skyline algorithm tests
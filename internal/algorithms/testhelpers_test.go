@@ -3,7 +3,7 @@ package algorithms
 import (
 	"reflect"
 
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // equalSkylineSet compares two datasets as sets (order-insensitive)
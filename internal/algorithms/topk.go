@@ -0,0 +1,61 @@
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// TopK returns the k points with the highest weighted utility, best first. Each non-ignored
+// dimension is normalized to [0, 1] over data, with 1 the best value, and weighted by the
+// matching entry of weights, which must be non-negative.
+//
+// Such a utility is monotone with respect to dominance, so a point with k or more dominators
+// is always outranked by k of them: only the k-skyband is scored.
+func TopK(data []types.Point, prefs types.Preference, weights []float64, k int) []types.Point {
+	if k <= 0 || len(data) == 0 {
+		return nil
+	}
+	lo, hi := orientedBounds(data, prefs)
+	candidates, _ := kSkybandIndices(data, prefs, k)
+	scores := make([]float64, len(candidates))
+	for i, c := range candidates {
+		for dim, order := range prefs {
+			if order == types.Ignore || hi[dim] == lo[dim] {
+				continue
+			}
+			scores[i] += weights[dim] * (hi[dim] - orientedValue(data[c], dim, order)) / (hi[dim] - lo[dim])
+		}
+	}
+
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	if k > len(order) {
+		k = len(order)
+	}
+	result := make([]types.Point, k)
+	for i := range result {
+		result[i] = data[candidates[order[i]]]
+	}
+	return result
+}
+
+// orientedBounds returns the per-dimension minimum and maximum of the oriented coordinates.
+func orientedBounds(data []types.Point, prefs types.Preference) ([]float64, []float64) {
+	lo := make([]float64, len(prefs))
+	hi := make([]float64, len(prefs))
+	for dim, order := range prefs {
+		lo[dim] = orientedValue(data[0], dim, order)
+		hi[dim] = lo[dim]
+		for _, p := range data[1:] {
+			v := orientedValue(p, dim, order)
+			lo[dim], hi[dim] = min(lo[dim], v), max(hi[dim], v)
+		}
+	}
+	return lo, hi
+}
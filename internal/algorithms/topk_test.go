@@ -0,0 +1,53 @@
+package algorithms
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestTopKMatchesFullRanking(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	data := make([]types.Point, 500)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(1000)), rng.Float64() * 5, float64(rng.Intn(50))}
+	}
+	prefs := types.Preference{types.Min, types.Max, types.Ignore}
+	weights := []float64{0.7, 0.3, 100}
+
+	lo, hi := orientedBounds(data, prefs)
+	score := func(p types.Point) float64 {
+		s := 0.0
+		for dim := 0; dim < 2; dim++ {
+			s += weights[dim] * (hi[dim] - orientedValue(p, dim, prefs[dim])) / (hi[dim] - lo[dim])
+		}
+		return s
+	}
+	ranked := append([]types.Point(nil), data...)
+	sort.SliceStable(ranked, func(i, j int) bool { return score(ranked[i]) > score(ranked[j]) })
+
+	for _, k := range []int{1, 5, 20} {
+		got := TopK(data, prefs, weights, k)
+		if len(got) != k {
+			t.Fatalf("k=%d: expected %d points, got %d", k, k, len(got))
+		}
+		for i := range got {
+			if score(got[i]) != score(ranked[i]) {
+				t.Errorf("k=%d: rank %d has score %v, expected %v", k, i, score(got[i]), score(ranked[i]))
+			}
+		}
+	}
+}
+
+func TestTopKSmallDataset(t *testing.T) {
+	data := []types.Point{{1, 1}, {2, 2}}
+	got := TopK(data, types.Preference{types.Min, types.Min}, []float64{1, 1}, 5)
+	if len(got) != 2 || got[0][0] != 1 {
+		t.Errorf("expected both points with {1, 1} first, got %v", got)
+	}
+	if got := TopK(data, types.Preference{types.Min, types.Min}, []float64{1, 1}, 0); got != nil {
+		t.Errorf("expected nil for k=0, got %v", got)
+	}
+}
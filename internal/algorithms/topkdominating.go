@@ -0,0 +1,88 @@
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// TopKDominating returns the k points that dominate the most other points, ordered by
+// decreasing dominance count.
+//
+// A point with k or more dominators can never be in the result, since each of its dominators
+// dominates strictly more points, so only the k-skyband is scored. Candidates are visited in
+// order of a per-dimension upper bound on their count and exact counting stops as soon as the
+// k-th best exact count reaches the next bound.
+func TopKDominating(data []types.Point, prefs types.Preference, k int) []types.DominanceScore {
+	if k <= 0 || len(data) == 0 {
+		return nil
+	}
+	candidates, _ := kSkybandIndices(data, prefs, k)
+	bounds := dominanceUpperBounds(data, prefs, candidates)
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bounds[order[i]] > bounds[order[j]]
+	})
+
+	var result []types.DominanceScore
+	for _, c := range order {
+		if len(result) == k && result[k-1].Count >= bounds[c] {
+			break
+		}
+		p := data[candidates[c]]
+		count := 0
+		for _, q := range data {
+			if utilities.DominatesEpsilon(p, q, prefs, 0) {
+				count++
+			}
+		}
+		result = insertByCount(result, types.DominanceScore{Point: p, Count: count}, k)
+	}
+	return result
+}
+
+// insertByCount inserts s into result, which is sorted by decreasing count and capped at k entries.
+func insertByCount(result []types.DominanceScore, s types.DominanceScore, k int) []types.DominanceScore {
+	pos := sort.Search(len(result), func(i int) bool { return result[i].Count < s.Count })
+	if pos >= k {
+		return result
+	}
+	if len(result) < k {
+		result = append(result, types.DominanceScore{})
+	}
+	copy(result[pos+1:], result[pos:])
+	result[pos] = s
+	return result
+}
+
+// dominanceUpperBounds bounds the dominance count of each candidate by the number of other points
+// that are no better than it in every single dimension, taking the minimum over dimensions.
+func dominanceUpperBounds(data []types.Point, prefs types.Preference, candidates []int) []int {
+	bounds := make([]int, len(candidates))
+	for i := range bounds {
+		bounds[i] = len(data) - 1
+	}
+	vals := make([]float64, len(data))
+	for dim, order := range prefs {
+		if order == types.Ignore {
+			continue
+		}
+		for i, p := range data {
+			vals[i] = orientedValue(p, dim, order)
+		}
+		sort.Float64s(vals)
+		for i, c := range candidates {
+			v := orientedValue(data[c], dim, order)
+			// points with an oriented value >= v, excluding the candidate itself
+			worse := len(vals) - sort.SearchFloat64s(vals, v) - 1
+			if worse < bounds[i] {
+				bounds[i] = worse
+			}
+		}
+	}
+	return bounds
+}
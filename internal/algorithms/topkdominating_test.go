@@ -0,0 +1,60 @@
+package algorithms
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func bruteForceDominanceCounts(data types.Dataset, prefs types.Preference) []int {
+	counts := make([]int, len(data))
+	for i, p := range data {
+		for _, q := range data {
+			if utilities.DominatesEpsilon(p, q, prefs, 0) {
+				counts[i]++
+			}
+		}
+	}
+	return counts
+}
+
+func TestTopKDominating(t *testing.T) {
+	data := types.Dataset{
+		{1, 9}, {2, 8}, {3, 3}, {4, 6}, {5, 5}, {6, 7}, {7, 2}, {8, 1}, {9, 9}, {4, 4}, {5, 8}, {6, 6},
+	}
+	prefs := types.Preference{types.Min, types.Min}
+	counts := bruteForceDominanceCounts(data, prefs)
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+
+	for k := 1; k <= len(data); k++ {
+		result := TopKDominating(data, prefs, k)
+		if len(result) != k {
+			t.Fatalf("k=%d: expected %d results, got %d", k, k, len(result))
+		}
+		for i, r := range result {
+			if r.Count != counts[i] {
+				t.Errorf("k=%d rank %d: expected count %d, got %d (%v)", k, i, counts[i], r.Count, r.Point)
+			}
+		}
+	}
+}
+
+func TestTopKDominating_Large(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min}
+	result := TopKDominating(Dataset1000CoupleDominating4D, prefs, 3)
+	counts := bruteForceDominanceCounts(Dataset1000CoupleDominating4D, prefs)
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+	for i, r := range result {
+		if r.Count != counts[i] {
+			t.Errorf("rank %d: expected count %d, got %d", i, counts[i], r.Count)
+		}
+	}
+}
+
+func TestTopKDominating_Empty(t *testing.T) {
+	if TopKDominating(DatasetEmpty, types.Preference{types.Min, types.Max}, 3) != nil {
+		t.Errorf("expected nil for empty dataset")
+	}
+}
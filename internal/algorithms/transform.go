@@ -0,0 +1,102 @@
+package algorithms
+
+import (
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// TransformView maps every point of data through t into the first dims transformed dimensions,
+// stored as a matrix whose rows follow data. The index-based BNL, D&C and SkyTree then run in the
+// transformed space unchanged, and each coordinate is transformed once instead of once per
+// comparison, at the cost of a transformed copy of the data.
+func TransformView(data []types.Point, dims int, t types.Transform) types.Matrix {
+	view := types.NewMatrix(len(data), dims)
+	for i, p := range data {
+		row := view.Row(i)
+		for dim := range row {
+			row[dim] = t.Value(p, dim)
+		}
+	}
+	return view
+}
+
+// TransformedSkyline computes the skyline of data in the space defined by t, where prefs refers
+// to the transformed dimensions. See TransformedSkylineIndices.
+func TransformedSkyline(data []types.Point, prefs types.Preference, t types.Transform, cfg BNLConfig) []types.Point {
+	return selectPoints(data, TransformedSkylineIndices(data, prefs, t, cfg))
+}
+
+// TransformedSkylineIndices returns the indices of the skyline points of data in the space
+// defined by t. Points are presorted by their transformed coordinates and then filtered with a
+// BNL window, so no transformed copy of the data is created. Only cfg.Epsilon is used; without it,
+// a MetricTransform goes through MetricTransformSkyline instead.
+func TransformedSkylineIndices(data []types.Point, prefs types.Preference, t types.Transform, cfg BNLConfig) []int {
+	if cfg.Epsilon == 0 {
+		if idx, ok := MetricTransformSkyline(data, prefs, t); ok {
+			return idx
+		}
+	}
+	order := monotoneOrderFunc(len(data), prefs, func(i, dim int, o types.Order) float64 {
+		return orientedTransformValue(data[i], dim, o, t)
+	})
+	var window []int
+	for _, i := range order {
+		dominated := false
+		for j := 0; j < len(window); {
+			w := data[window[j]]
+			if utilities.DominatesTransform(w, data[i], prefs, t, cfg.Epsilon) {
+				dominated = true
+				break
+			} else if utilities.DominatesTransform(data[i], w, prefs, t, cfg.Epsilon) {
+				window = append(window[:j], window[j+1:]...)
+			} else {
+				j++
+			}
+		}
+		if !dominated {
+			window = append(window, i)
+		}
+	}
+	return window
+}
+
+// selectPoints returns the points of data at the given indices.
+func selectPoints(data []types.Point, idx []int) []types.Point {
+	result := make([]types.Point, len(idx))
+	for i, j := range idx {
+		result[i] = data[j]
+	}
+	return result
+}
+
+// QuerySkyline computes the dynamic skyline of data relative to q: every non-ignored coordinate
+// is replaced by its distance to q, which is minimized.
+func QuerySkyline(data []types.Point, prefs types.Preference, q types.Point, cfg BNLConfig) []types.Point {
+	return TransformedSkyline(data, MinimizeAll(prefs), types.QueryTransform{Query: q}, cfg)
+}
+
+// MinimizeAll returns prefs with every non-ignored dimension set to Min, as used for distances.
+func MinimizeAll(prefs types.Preference) types.Preference {
+	out := make(types.Preference, len(prefs))
+	for i, order := range prefs {
+		if order != types.Ignore {
+			order = types.Min
+		}
+		out[i] = order
+	}
+	return out
+}
+
+func orientedTransformValue(p types.Point, dim int, order types.Order, t types.Transform) float64 {
+	switch order {
+	case types.Min:
+		return t.Value(p, dim)
+	case types.Max:
+		return -t.Value(p, dim)
+	default:
+		if po := order.PartialOrder(); po != nil {
+			return float64(po.Depth(int(t.Value(p, dim))))
+		}
+		return 0
+	}
+}
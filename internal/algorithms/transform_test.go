@@ -0,0 +1,49 @@
+package algorithms
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func TestQuerySkyline(t *testing.T) {
+	// weight in kg, price: a laptop close to 1.5kg and close to $900
+	data := types.Dataset{
+		{1.2, 1100}, {1.5, 1200}, {1.4, 950}, {2.0, 900}, {1.6, 880}, {2.5, 600}, {1.0, 1500}, {1.45, 920},
+	}
+	q := types.Point{1.5, 900}
+	prefs := types.Preference{types.Max, types.Min}
+
+	// Reference: materialize the distances and run plain BNL on them
+	materialized := make(types.Dataset, len(data))
+	for i, p := range data {
+		materialized[i] = types.Point{math.Abs(p[0] - q[0]), math.Abs(p[1] - q[1])}
+	}
+	reference := BlockNestedLoop(materialized, types.Preference{types.Min, types.Min})
+	var expected types.Dataset
+	for i, m := range materialized {
+		for _, r := range reference {
+			if isPointEqual(m, r) {
+				expected = append(expected, data[i])
+				break
+			}
+		}
+	}
+
+	result := QuerySkyline(data, prefs, q, BNLConfig{})
+	if !equalSkylineSet(result, expected) {
+		t.Errorf("QuerySkyline incorrect: got %v, want %v", result, expected)
+	}
+}
+
+func TestQuerySkyline_Ignore(t *testing.T) {
+	data := types.Dataset{{1, 100, 5}, {2, 0, 5}, {3, 50, 4}}
+	q := types.Point{0, 0, 5}
+	prefs := types.Preference{types.Min, types.Ignore, types.Min}
+	result := QuerySkyline(data, prefs, q, BNLConfig{})
+	expected := types.Dataset{{1, 100, 5}}
+	if !equalSkylineSet(result, expected) {
+		t.Errorf("QuerySkyline with ignored dimension incorrect: got %v, want %v", result, expected)
+	}
+}
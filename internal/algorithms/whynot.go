@@ -0,0 +1,41 @@
+package algorithms
+
+import (
+	"math"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// WhyNot returns the skyline points of data that dominate x and, per dimension, the minimal change
+// to x that would put it on the skyline.
+//
+// Every dominator of a dominator of x dominates x too, so the skyline points dominating x are the
+// skyline of x's dominators, found with one scan and an SFS pass over the dominators only.
+// Improving x in a single dimension d escapes a dominator s once x[d] is strictly better than s[d],
+// and only points dominating x can dominate an improved x, so the minimal change is to the best
+// value of d among the skyline dominators. Ignored and categorical dimensions get NaN.
+func WhyNot(data []types.Point, prefs types.Preference, x types.Point) types.WhyNotAnswer {
+	var dominators []types.Point
+	for _, p := range data {
+		if utilities.DominatesEpsilon(p, x, prefs, 0) {
+			dominators = append(dominators, p)
+		}
+	}
+	answer := types.WhyNotAnswer{Changes: make(types.Point, len(prefs))}
+	var best types.Point
+	if len(dominators) > 0 {
+		answer.Dominators = SFS(dominators, prefs, BNLConfig{})
+		best = boundingBox(answer.Dominators, prefs).best
+	}
+
+	for dim, order := range prefs {
+		switch {
+		case order != types.Min && order != types.Max:
+			answer.Changes[dim] = math.NaN()
+		case best != nil:
+			answer.Changes[dim] = best[dim] - x[dim]
+		}
+	}
+	return answer
+}
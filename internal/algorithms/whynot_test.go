@@ -0,0 +1,74 @@
+package algorithms
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+func dominatedByAnyPoint(data []types.Point, x types.Point, prefs types.Preference) bool {
+	for _, p := range data {
+		if utilities.DominatesEpsilon(p, x, prefs, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestWhyNotChangesAreMinimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(50))
+	prefs := types.Preference{types.Min, types.Max, types.Min}
+	data := make([]types.Point, 200)
+	for i := range data {
+		data[i] = types.Point{float64(rng.Intn(50)), float64(rng.Intn(50)), float64(rng.Intn(50))}
+	}
+	sky := SFS(data, prefs, BNLConfig{})
+
+	for _, x := range data[:50] {
+		answer := WhyNot(data, prefs, x)
+		var want types.Dataset
+		for _, s := range sky {
+			if utilities.DominatesEpsilon(s, x, prefs, 0) {
+				want = append(want, s)
+			}
+		}
+		if !equalSkylineSet(answer.Dominators, want) {
+			t.Fatalf("dominators of %v: expected %v, got %v", x, want, answer.Dominators)
+		}
+		for dim, change := range answer.Changes {
+			if len(want) == 0 {
+				if change != 0 {
+					t.Errorf("skyline point %v should need no change, got %v", x, answer.Changes)
+				}
+				continue
+			}
+			// Step strictly past the boundary in the improving direction
+			step := 0.5
+			if prefs[dim] == types.Min {
+				step = -0.5
+			}
+			beyond := append(types.Point(nil), x...)
+			beyond[dim] += change + step
+			if dominatedByAnyPoint(data, beyond, prefs) {
+				t.Errorf("%v moved to %v in dim %d is still dominated", x, beyond[dim], dim)
+			}
+			short := append(types.Point(nil), x...)
+			short[dim] += change / 2
+			if change != 0 && !dominatedByAnyPoint(data, short, prefs) {
+				t.Errorf("%v moved halfway to %v in dim %d is already on the skyline", x, short[dim], dim)
+			}
+		}
+	}
+}
+
+func TestWhyNotIgnoredDimension(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Ignore}
+	data := []types.Point{{1, 9}, {3, 0}}
+	answer := WhyNot(data, prefs, types.Point{3, 0})
+	if len(answer.Dominators) != 1 || answer.Changes[0] != -2 || !math.IsNaN(answer.Changes[1]) {
+		t.Errorf("expected dominator {1, 9} and changes [-2 NaN], got %+v", answer)
+	}
+}
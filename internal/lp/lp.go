@@ -0,0 +1,107 @@
+// Package lp solves small dense linear programs with the simplex method.
+package lp
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrUnbounded is returned when the objective can grow without limit.
+var ErrUnbounded = errors.New("lp: unbounded")
+
+// ErrInfeasible is returned when the origin is not feasible, i.e. some b[i] is negative.
+var ErrInfeasible = errors.New("lp: negative right-hand side")
+
+const tolerance = 1e-9
+
+// Maximize solves max c·x subject to A x <= b and x >= 0, where every b[i] must be non-negative
+// so that the slack basis is a feasible starting point. Pivots follow Bland's rule, which cannot
+// cycle on degenerate programs. It returns an optimal x and the objective value.
+func Maximize(c []float64, A [][]float64, b []float64) ([]float64, float64, error) {
+	m, n := len(A), len(c)
+	for _, v := range b {
+		if v < 0 {
+			return nil, 0, ErrInfeasible
+		}
+	}
+
+	// Tableau rows 0..m-1 hold the constraints, row m the negated objective.
+	// Columns 0..n-1 are the variables, n..n+m-1 the slacks and n+m the right-hand side.
+	width := n + m + 1
+	t := make([][]float64, m+1)
+	basis := make([]int, m)
+	for i := 0; i < m; i++ {
+		t[i] = make([]float64, width)
+		copy(t[i], A[i])
+		t[i][n+i] = 1
+		t[i][n+m] = b[i]
+		basis[i] = n + i
+	}
+	t[m] = make([]float64, width)
+	for j, v := range c {
+		t[m][j] = -v
+	}
+
+	for {
+		col := enteringColumn(t[m][:n+m])
+		if col < 0 {
+			break
+		}
+		row := leavingRow(t[:m], basis, col)
+		if row < 0 {
+			return nil, 0, ErrUnbounded
+		}
+		pivot(t, row, col)
+		basis[row] = col
+	}
+
+	x := make([]float64, n)
+	for i, v := range basis {
+		if v < n {
+			x[v] = t[i][n+m]
+		}
+	}
+	return x, t[m][n+m], nil
+}
+
+// enteringColumn returns the lowest-indexed column with a negative reduced cost, or -1 at the optimum.
+func enteringColumn(costs []float64) int {
+	for j, v := range costs {
+		if v < -tolerance {
+			return j
+		}
+	}
+	return -1
+}
+
+// leavingRow applies the minimum ratio test, breaking ties by the lowest basic variable.
+// It returns -1 if no row limits the entering column.
+func leavingRow(rows [][]float64, basis []int, col int) int {
+	best, bestRatio := -1, math.Inf(1)
+	for i, r := range rows {
+		if r[col] <= tolerance {
+			continue
+		}
+		ratio := r[len(r)-1] / r[col]
+		if ratio < bestRatio-tolerance || (ratio <= bestRatio+tolerance && best >= 0 && basis[i] < basis[best]) {
+			best, bestRatio = i, ratio
+		}
+	}
+	return best
+}
+
+func pivot(t [][]float64, row, col int) {
+	p := t[row][col]
+	for j := range t[row] {
+		t[row][j] /= p
+	}
+	for i := range t {
+		if i == row || t[i][col] == 0 {
+			continue
+		}
+		f := t[i][col]
+		for j := range t[i] {
+			t[i][j] -= f * t[row][j]
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package lp
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMaximize(t *testing.T) {
+	// max 3x + 5y s.t. x <= 4, 2y <= 12, 3x + 2y <= 18 -> x=2, y=6, value 36
+	c := []float64{3, 5}
+	A := [][]float64{{1, 0}, {0, 2}, {3, 2}}
+	b := []float64{4, 12, 18}
+	x, value, err := Maximize(c, A, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(value-36) > 1e-9 || math.Abs(x[0]-2) > 1e-9 || math.Abs(x[1]-6) > 1e-9 {
+		t.Errorf("expected x=(2,6) value 36, got x=%v value %v", x, value)
+	}
+}
+
+func TestMaximizeDegenerate(t *testing.T) {
+	// Zero right-hand sides make every pivot degenerate; Bland's rule must still terminate.
+	c := []float64{10, -57, -9, -24}
+	A := [][]float64{
+		{0.5, -5.5, -2.5, 9},
+		{0.5, -1.5, -0.5, 1},
+		{1, 0, 0, 0},
+	}
+	b := []float64{0, 0, 1}
+	_, value, err := Maximize(c, A, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(value-1) > 1e-9 {
+		t.Errorf("expected value 1, got %v", value)
+	}
+}
+
+func TestMaximizeUnbounded(t *testing.T) {
+	_, _, err := Maximize([]float64{1, 1}, [][]float64{{1, -1}}, []float64{1})
+	if !errors.Is(err, ErrUnbounded) {
+		t.Errorf("expected ErrUnbounded, got %v", err)
+	}
+}
+
+func TestMaximizeInfeasibleStart(t *testing.T) {
+	_, _, err := Maximize([]float64{1}, [][]float64{{1}}, []float64{-1})
+	if !errors.Is(err, ErrInfeasible) {
+		t.Errorf("expected ErrInfeasible, got %v", err)
+	}
+}
@@ -1,11 +1,40 @@
 package utilities
 
 import (
+	"math"
 	"testing"
 
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
+func TestCompare(t *testing.T) {
+	po, err := types.NewPartialOrder(3, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cases := []struct {
+		name     string
+		av, bv   float64
+		order    types.Order
+		epsilon  float64
+		expected Comparison
+	}{
+		{"MinBetter", 1, 2, types.Min, 0, Better},
+		{"MinWorse", 2, 1, types.Min, 0, Worse},
+		{"MaxBetter", 2, 1, types.Max, 0, Better},
+		{"WithinEpsilon", 1, 1.05, types.Min, 0.1, Equal},
+		{"Ignore", 1, 2, types.Ignore, 0, Equal},
+		{"PreferredCategory", 0, 1, types.Categorical(po), 0, Better},
+		{"LessPreferredCategory", 1, 0, types.Categorical(po), 0, Worse},
+		{"IncomparableCategories", 1, 2, types.Categorical(po), 0, Incomparable},
+	}
+	for _, c := range cases {
+		if got := Compare(c.av, c.bv, c.order, c.epsilon); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+}
+
 func TestDominatesEpsilon_Zero(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -110,3 +139,197 @@ func TestDominatesEpsilon_VaryingEpsilon(t *testing.T) {
 		})
 	}
 }
+
+func TestDominatesEpsilon_Generic(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Max}
+
+	if !DominatesEpsilon(types.PointOf[int32]{100, 7}, types.PointOf[int32]{120, 7}, prefs, 0) {
+		t.Errorf("expected int32 domination")
+	}
+	if DominatesEpsilon(types.PointOf[int32]{100, 7}, types.PointOf[int32]{120, 7}, prefs, 20) {
+		t.Errorf("expected int32 epsilon 20 to cover the price difference")
+	}
+	if !DominatesEpsilon(types.PointOf[float32]{0.5, 0.9}, types.PointOf[float32]{0.5, 0.8}, prefs, 0) {
+		t.Errorf("expected float32 domination")
+	}
+	if DominatesEpsilon(types.PointOf[int64]{1, 1}, types.PointOf[int64]{1, 1}, prefs, 0) {
+		t.Errorf("equal int64 points must not dominate each other")
+	}
+}
+
+func TestDominatesK(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Min, types.Min, types.Min}
+	cases := []struct {
+		name     string
+		a, b     types.Point
+		k        int
+		expected bool
+	}{
+		{"FullDominanceIsKDominance", types.Point{1, 1, 1, 1}, types.Point{2, 2, 2, 2}, 4, true},
+		{"ThreeOfFour", types.Point{1, 1, 1, 9}, types.Point{2, 2, 2, 2}, 3, true},
+		{"ThreeOfFourNotEnoughForFour", types.Point{1, 1, 1, 9}, types.Point{2, 2, 2, 2}, 4, false},
+		{"TwoOfFour", types.Point{1, 1, 9, 9}, types.Point{2, 2, 2, 2}, 3, false},
+		{"EqualDimsCountButNeedStrict", types.Point{2, 2, 2, 9}, types.Point{2, 2, 2, 2}, 3, false},
+		{"EqualDimsPlusOneStrict", types.Point{2, 2, 1, 9}, types.Point{2, 2, 2, 2}, 3, true},
+		{"EqualPoints", types.Point{2, 2, 2, 2}, types.Point{2, 2, 2, 2}, 1, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DominatesK(tc.a, tc.b, prefs, tc.k); got != tc.expected {
+				t.Errorf("DominatesK(%v, %v, %d) = %v, want %v", tc.a, tc.b, tc.k, got, tc.expected)
+			}
+		})
+	}
+
+	// Ignored dimensions do not count towards k
+	ignore := types.Preference{types.Min, types.Ignore, types.Max}
+	if DominatesK(types.Point{1, 0, 1}, types.Point{2, 5, 2}, ignore, 2) {
+		t.Errorf("ignored dimension must not count towards k")
+	}
+	if !DominatesK(types.Point{1, 0, 3}, types.Point{2, 5, 2}, ignore, 2) {
+		t.Errorf("expected 2-dominance over the non-ignored dimensions")
+	}
+}
+
+func TestDominatesTransform(t *testing.T) {
+	q := types.QueryTransform{Query: types.Point{10, 10}}
+	prefs := types.Preference{types.Min, types.Min}
+	if !DominatesTransform(types.Point{11, 9}, types.Point{7, 14}, prefs, q, 0) {
+		t.Errorf("expected {11,9} to be closer to the query than {7,14} in both dimensions")
+	}
+	if DominatesTransform(types.Point{11, 9}, types.Point{9, 11}, prefs, q, 0) {
+		t.Errorf("points at equal distances must not dominate each other")
+	}
+	if DominatesTransform(types.Point{12, 10}, types.Point{9, 13}, prefs, q, 0) {
+		t.Errorf("{12,10} is farther in the first dimension and must not dominate")
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	c := types.Constraints{{Min: 200, Max: 800}, types.Unbounded, {Min: 0, Max: 2}}
+	cases := []struct {
+		p        types.Point
+		expected bool
+	}{
+		{types.Point{500, -1e9, 1}, true},
+		{types.Point{200, 0, 2}, true},
+		{types.Point{199.99, 0, 1}, false},
+		{types.Point{500, 0, 2.5}, false},
+		{types.Point{500, 0, 1, 99}, true}, // dimensions beyond the constraints are free
+	}
+	for _, tc := range cases {
+		if got := Satisfies(tc.p, c); got != tc.expected {
+			t.Errorf("Satisfies(%v) = %v, want %v", tc.p, got, tc.expected)
+		}
+	}
+	if !Satisfies(types.Point{1, 2}, nil) {
+		t.Errorf("nil constraints must admit every point")
+	}
+}
+
+func TestDominatesStrict(t *testing.T) {
+	prefs := types.Preference{types.Min, types.Max, types.Ignore}
+	if !DominatesStrict(types.Point{1, 5, 9}, types.Point{2, 4, 0}, prefs) {
+		t.Errorf("expected strict dominance in both non-ignored dimensions")
+	}
+	if DominatesStrict(types.Point{1, 4, 0}, types.Point{2, 4, 0}, prefs) {
+		t.Errorf("a tie in one dimension must prevent strict dominance")
+	}
+	if DominatesStrict(types.Point{1}, types.Point{2}, types.Preference{types.Ignore}) {
+		t.Errorf("no dimensions to compare must not dominate")
+	}
+}
+
+func TestEclipseDominates(t *testing.T) {
+	// price (Min), rating (Max); the rating weight is the reference, price weighs 0.5 to 2 times as much
+	prefs := types.Preference{types.Min, types.Max}
+	ratios := []types.WeightRatio{{Low: 0.5, High: 2}}
+	cases := []struct {
+		name     string
+		a, b     types.Point
+		expected bool
+	}{
+		{"Dominance", types.Point{1, 5}, types.Point{2, 4}, true},
+		// a scores -4 and -1 at the two corners, b -3.5 and -2: each wins at one corner
+		{"SplitCorners", types.Point{2, 5}, types.Point{1, 4}, false},
+		{"CheaperButMuchWorse", types.Point{1, 1}, types.Point{2, 5}, false},
+		// a scores -9 and -6, b -3.5 and -2: better at both corners without ordinary dominance
+		{"EclipseOnly", types.Point{2, 10}, types.Point{1, 4}, true},
+		{"Equal", types.Point{1, 4}, types.Point{1, 4}, false},
+	}
+	for _, c := range cases {
+		if got := EclipseDominates(c.a, c.b, prefs, ratios); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+
+	// With Low == High the relation is a comparison of fixed weighted sums
+	fixed := []types.WeightRatio{{Low: 1, High: 1}}
+	if !EclipseDominates(types.Point{3, 5}, types.Point{1, 2}, prefs, fixed) {
+		t.Errorf("expected -2 to beat -1 under fixed weights")
+	}
+}
+
+func TestDominatesEpsilon_Categorical(t *testing.T) {
+	// 0 > 1 > 3 and 0 > 2 > 3; 1 and 2 are incomparable
+	po, err := types.NewPartialOrder(4, [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	prefs := types.Preference{types.Min, types.Categorical(po)}
+	cases := []struct {
+		name     string
+		a, b     types.Point
+		expected bool
+	}{
+		{"PreferredCategory", types.Point{5, 0}, types.Point{5, 1}, true},
+		{"TransitivePreference", types.Point{5, 0}, types.Point{5, 3}, true},
+		{"SameCategoryBetterNumber", types.Point{4, 2}, types.Point{5, 2}, true},
+		{"IncomparableCategories", types.Point{4, 1}, types.Point{5, 2}, false},
+		{"WorseCategory", types.Point{4, 3}, types.Point{5, 1}, false},
+		{"UnknownCode", types.Point{4, 9}, types.Point{5, 9}, true},
+		{"UnknownCodeIncomparable", types.Point{4, 0}, types.Point{5, 9}, false},
+	}
+	for _, c := range cases {
+		if got := DominatesEpsilon(c.a, c.b, prefs, 0); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+	if types.Categorical(po) != prefs[1] {
+		t.Errorf("categorical orders over the same PartialOrder must be equal")
+	}
+	if po.Depth(0) != 0 || po.Depth(1) != 1 || po.Depth(3) != 2 {
+		t.Errorf("unexpected depths %d, %d, %d", po.Depth(0), po.Depth(1), po.Depth(3))
+	}
+}
+
+func TestNewPartialOrderErrors(t *testing.T) {
+	if _, err := types.NewPartialOrder(3, [][2]int{{0, 1}, {1, 2}, {2, 0}}); err == nil {
+		t.Errorf("expected an error for a cycle")
+	}
+	if _, err := types.NewPartialOrder(2, [][2]int{{0, 2}}); err == nil {
+		t.Errorf("expected an error for an out-of-range category")
+	}
+}
+
+func TestDominatesIncomplete(t *testing.T) {
+	nan := math.NaN()
+	prefs := types.Preference{types.Min, types.Max, types.Min}
+	cases := []struct {
+		name     string
+		a, b     types.Point
+		expected bool
+	}{
+		{"Complete", types.Point{1, 5, 1}, types.Point{2, 4, 1}, true},
+		{"SkipsMissingInA", types.Point{nan, 5, 1}, types.Point{0, 4, 1}, true},
+		{"SkipsMissingInB", types.Point{1, 5, 9}, types.Point{2, 4, nan}, true},
+		{"WorseOnShared", types.Point{3, nan, 1}, types.Point{2, 4, 1}, false},
+		{"EqualOnShared", types.Point{1, nan, 1}, types.Point{1, 9, 1}, false},
+		{"NothingShared", types.Point{1, nan, nan}, types.Point{nan, 4, 2}, false},
+	}
+	for _, c := range cases {
+		if got := DominatesIncomplete(c.a, c.b, prefs, 0); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+}
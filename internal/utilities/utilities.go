@@ -1,9 +1,88 @@
 package utilities
 
-import "github.com/gkoos/skyline/types"
+import (
+	"math"
+
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// Comparison is the outcome of comparing a value against another in one dimension.
+type Comparison int
+
+const (
+	Worse Comparison = iota
+	Equal
+	Better
+	Incomparable // different categories with no preference between them
+)
+
+// Compare compares av against bv in a dimension with the given order, treating values within
+// epsilon as equal. Ignored dimensions compare Equal. In categorical dimensions (see
+// types.Categorical) a value is better if its category is preferred, and epsilon does not apply.
+// This is the per-dimension step of every dominance relation in this package.
+func Compare[T types.Number](av, bv T, order types.Order, epsilon T) Comparison {
+	switch order {
+	case types.Min:
+		return compareOriented(av, bv, epsilon)
+	case types.Max:
+		return compareOriented(bv, av, epsilon)
+	case types.Ignore:
+		return Equal
+	default:
+		return compareCategorical(av, bv, order)
+	}
+}
+
+// compareOriented compares av against bv where smaller is better.
+func compareOriented[T types.Number](av, bv, epsilon T) Comparison {
+	if av > bv+epsilon {
+		return Worse
+	}
+	if av < bv-epsilon {
+		return Better
+	}
+	return Equal
+}
+
+func compareCategorical[T types.Number](av, bv T, order types.Order) Comparison {
+	if av == bv {
+		return Equal
+	}
+	po := order.PartialOrder()
+	switch {
+	case po == nil:
+		return Incomparable
+	case po.Prefers(int(av), int(bv)):
+		return Better
+	case po.Prefers(int(bv), int(av)):
+		return Worse
+	default:
+		return Incomparable
+	}
+}
 
 // DominatesEpsilon returns true if a dominates b according to the given preferences, allowing a tolerance epsilon.
-func DominatesEpsilon(a, b types.Point, prefs types.Preference, epsilon float64) bool {
+// It is generic over the coordinate type; for integer coordinates epsilon is an integer tolerance.
+// In categorical dimensions (see types.Categorical) a is better if its category is preferred, and
+// different categories that are not preferred over each other make a and b incomparable.
+func DominatesEpsilon[T types.Number](a, b types.PointOf[T], prefs types.Preference, epsilon T) bool {
+	anyBetter := false
+	for dim, order := range prefs {
+		switch Compare(a[dim], b[dim], order, epsilon) {
+		case Worse, Incomparable:
+			return false
+		case Better:
+			anyBetter = true
+		}
+	}
+	return anyBetter
+}
+
+// DominatesK returns true if a k-dominates b: a is at least as good as b in at least k of the
+// non-ignored dimensions and strictly better in at least one of them.
+// Unlike ordinary dominance, k-dominance is not transitive and may even be cyclic.
+func DominatesK[T types.Number](a, b types.PointOf[T], prefs types.Preference, k int) bool {
+	atLeastAsGood := 0
 	anyBetter := false
 
 	for dim, order := range prefs {
@@ -11,21 +90,133 @@ func DominatesEpsilon(a, b types.Point, prefs types.Preference, epsilon float64)
 			continue
 		}
 
-		av, bv := a[dim], b[dim]
-		if order == types.Min {
-			if av > bv+epsilon {
-				return false
-			}
-			if av < bv-epsilon {
-				anyBetter = true
-			}
-		} else { // types.Max
-			if av < bv-epsilon {
-				return false
-			}
-			if av > bv+epsilon {
-				anyBetter = true
+		switch Compare(a[dim], b[dim], order, 0) {
+		case Equal:
+			atLeastAsGood++
+		case Better:
+			atLeastAsGood++
+			anyBetter = true
+		}
+	}
+	return anyBetter && atLeastAsGood >= k
+}
+
+// DominatesTransform returns true if a dominates b after both are mapped through t.
+// prefs applies to the transformed coordinates.
+func DominatesTransform(a, b types.Point, prefs types.Preference, t types.Transform, epsilon float64) bool {
+	anyBetter := false
+	for dim, order := range prefs {
+		if order == types.Ignore {
+			continue
+		}
+		switch Compare(t.Value(a, dim), t.Value(b, dim), order, epsilon) {
+		case Worse, Incomparable:
+			return false
+		case Better:
+			anyBetter = true
+		}
+	}
+	return anyBetter
+}
+
+// Satisfies returns true if every coordinate of p lies within its range in c.
+func Satisfies[T types.Number](p types.PointOf[T], c types.Constraints) bool {
+	for dim, r := range c {
+		if dim >= len(p) {
+			break
+		}
+		v := float64(p[dim])
+		if v < r.Min || v > r.Max {
+			return false
+		}
+	}
+	return true
+}
+
+// DominatesStrict returns true if a is strictly better than b in every non-ignored dimension.
+// This is the relation behind the extended skyline, which unlike the skyline contains the
+// skyline of every subspace.
+func DominatesStrict[T types.Number](a, b types.PointOf[T], prefs types.Preference) bool {
+	anyDim := false
+	for dim, order := range prefs {
+		if order == types.Ignore {
+			continue
+		}
+		anyDim = true
+		if Compare(a[dim], b[dim], order, 0) != Better {
+			return false
+		}
+	}
+	return anyDim
+}
+
+// EclipseScores returns the scores of p, lower being better, under every extreme weighting allowed
+// by ratios. The last non-ignored dimension is the reference with weight 1, and ratios[i] bounds
+// the weight of the i-th other non-ignored dimension; Max dimensions are negated. Categorical
+// dimensions have no numeric value to weight and are skipped, so callers must reject them. Score k uses
+// ratios[i].High if bit i of k is set and ratios[i].Low otherwise, so there are 2^len(ratios) scores.
+func EclipseScores(p types.Point, prefs types.Preference, ratios []types.WeightRatio) []float64 {
+	var oriented []float64
+	for dim, order := range prefs {
+		switch order {
+		case types.Min:
+			oriented = append(oriented, p[dim])
+		case types.Max:
+			oriented = append(oriented, -p[dim])
+		}
+	}
+	if len(oriented) == 0 {
+		return nil
+	}
+	ref := oriented[len(oriented)-1]
+	scores := make([]float64, 1<<len(ratios))
+	for k := range scores {
+		score := ref
+		for i, r := range ratios {
+			w := r.Low
+			if k&(1<<i) != 0 {
+				w = r.High
 			}
+			score += w * oriented[i]
+		}
+		scores[k] = score
+	}
+	return scores
+}
+
+// EclipseDominates returns true if a eclipse-dominates b: a scores at least as well as b under
+// every linear weighting allowed by ratios, and strictly better under at least one. Scores are
+// linear in the weights, so it is enough to compare them at the corners of the ratio box.
+// Wider ratio bounds approach ordinary dominance; with Low == High it is a fixed-weight comparison.
+func EclipseDominates(a, b types.Point, prefs types.Preference, ratios []types.WeightRatio) bool {
+	as, bs := EclipseScores(a, prefs, ratios), EclipseScores(b, prefs, ratios)
+	anyBetter := false
+	for k := range as {
+		if as[k] > bs[k] {
+			return false
+		}
+		if as[k] < bs[k] {
+			anyBetter = true
+		}
+	}
+	return anyBetter
+}
+
+// DominatesIncomplete returns true if a dominates b on the dimensions both of them have: a missing
+// value is NaN, and a dimension missing in either point is skipped. a must be at least as good in
+// every shared non-ignored dimension and strictly better in one. Points without a shared dimension
+// never dominate each other. Unlike ordinary dominance this relation is not transitive and may be cyclic.
+func DominatesIncomplete(a, b types.Point, prefs types.Preference, epsilon float64) bool {
+	anyBetter := false
+	for dim, order := range prefs {
+		if order == types.Ignore || math.IsNaN(a[dim]) || math.IsNaN(b[dim]) {
+			continue
+		}
+		switch Compare(a[dim], b[dim], order, epsilon) {
+		case Worse, Incomparable:
+			return false
+		case Better:
+			anyBetter = true
 		}
 	}
 	return anyBetter
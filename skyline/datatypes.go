@@ -1,7 +1,7 @@
 package skyline
 
 import (
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // Point represents a multi-dimensional data point for skyline queries.
@@ -13,10 +13,86 @@ type Dataset = types.Dataset
 // Preference maps each dimension to an optimization order (Min or Max).
 type Preference = types.Preference
 
-// Order specifies whether a dimension should be minimized or maximized.
+// Number is the set of coordinate types accepted by SkylineOf and SkylineMatrix.
+type Number = types.Number
+
+// Matrix stores points contiguously in row-major order for cache-friendly skyline computation.
+type Matrix = types.Matrix
+
+// DominanceScore pairs a point with the number of points it dominates.
+type DominanceScore = types.DominanceScore
+
+// Transform maps points to the coordinates used for dominance checks, evaluated on the fly.
+type Transform = types.Transform
+
+// QueryTransform maps each coordinate to its distance from a query point (dynamic skyline).
+type QueryTransform = types.QueryTransform
+
+// MetricTransform maps a point to its distances to query points under a user-supplied metric.
+type MetricTransform = types.MetricTransform
+
+// Range is a closed interval of allowed values for one dimension.
+type Range = types.Range
+
+// Constraints restricts each dimension to a Range for constrained skyline queries.
+type Constraints = types.Constraints
+
+// UncertainPoint is an object with several weighted instances, used by probabilistic skyline queries.
+type UncertainPoint = types.UncertainPoint
+
+// StreamWindow bounds the live points of a Stream by count and/or timestamp.
+type StreamWindow = types.StreamWindow
+
+// WeightRatio bounds the weight of one dimension relative to the reference dimension in eclipse queries.
+type WeightRatio = types.WeightRatio
+
+// Aggregate combines the values of a group of points in one dimension (SumAggregate, MinAggregate, MaxAggregate).
+type Aggregate = types.Aggregate
+
+// Group is a set of points with their per-dimension aggregate, as returned by GroupSkyline.
+type Group = types.Group
+
+// WhyNotAnswer lists the skyline points dominating a point and the per-dimension changes that would
+// put it on the skyline, as returned by WhyNot.
+type WhyNotAnswer = types.WhyNotAnswer
+
+// Order specifies whether a dimension should be minimized, maximized, ignored or compared by category.
 type Order = types.Order
 
+var (
+	Min    = types.Min    // Minimize this dimension
+	Max    = types.Max    // Maximize this dimension
+	Ignore = types.Ignore // Skip this dimension in dominance comparisons
+)
+
+// PartialOrder ranks the category codes of a categorical dimension by a preference DAG.
+type PartialOrder = types.PartialOrder
+
+// NewPartialOrder builds a PartialOrder over codes 0..categories-1, where edge {a, b} means a is preferred over b.
+func NewPartialOrder(categories int, edges [][2]int) (*PartialOrder, error) {
+	return types.NewPartialOrder(categories, edges)
+}
+
+// Categorical returns the Order for a dimension whose values are category codes ranked by po.
+func Categorical(po *PartialOrder) Order {
+	return types.Categorical(po)
+}
+
 const (
-	Min = types.Min // Minimize this dimension
-	Max = types.Max // Maximize this dimension
+	SumAggregate = types.SumAggregate // Sum of the group's values
+	MinAggregate = types.MinAggregate // Smallest of the group's values
+	MaxAggregate = types.MaxAggregate // Largest of the group's values
 )
+
+// Unbounded is the Range that admits every value.
+var Unbounded = types.Unbounded
+
+// NewMatrix allocates a zeroed rows x cols Matrix.
+func NewMatrix(rows, cols int) Matrix {
+	return types.NewMatrix(rows, cols)
+}
+
+// MatrixFromDataset copies a Dataset into a contiguous Matrix.
+func MatrixFromDataset(data Dataset) Matrix {
+	return types.MatrixFromDataset(data)
+}
@@ -0,0 +1,13 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// TopKDominating returns the k points that dominate the largest number of other points,
+// with their dominance counts, ordered from the highest count down.
+// Only k-skyband points are scored, and counting stops once no remaining candidate can
+// beat the current k-th count.
+func TopKDominating(points []Point, prefs Preference, k int) []DominanceScore {
+	return algorithms.TopKDominating(points, prefs, k)
+}
@@ -0,0 +1,19 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestTopKDominating(t *testing.T) {
+	points := []Point{{1, 1}, {2, 2}, {3, 3}, {0, 5}, {4, 4}}
+	result := TopKDominating(points, Preference{Min, Min}, 2)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 results, got %v", result)
+	}
+	if !equalPoint(result[0].Point, Point{1, 1}) || result[0].Count != 3 {
+		t.Errorf("expected {1,1} dominating 3 points first, got %v", result[0])
+	}
+	if !equalPoint(result[1].Point, Point{2, 2}) || result[1].Count != 2 {
+		t.Errorf("expected {2,2} dominating 2 points second, got %v", result[1])
+	}
+}
@@ -2,7 +2,8 @@
 package skyline
 
 import (
-	"github.com/gkoos/skyline/internal/utilities"
+	"github.com/gkoos/skyline/v2/internal/utilities"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // Engine is the interface for dynamic skyline operations.
@@ -14,6 +15,14 @@ type Engine interface {
 	Skyline() []Point
 }
 
+// TopKEngine is an Engine that also answers weighted top-k queries over its stored points, so the
+// same engine serves both the skyline and a weighted ranking. DynamicSkyline,
+// DynamicConstrainedSkyline and DynamicSkylineRaw return one.
+type TopKEngine interface {
+	Engine
+	TopK(weights []float64, k int) ([]Point, error)
+}
+
 // internal engine struct, all fields private
 type engine struct {
 	points  []Point
@@ -21,11 +30,20 @@ type engine struct {
 	prefs   Preference
 	algo    string
 	skyline []Point // always up-to-date skyline set
+
+	constraints types.Constraints // points outside these ranges are stored but never enter the skyline
+	transform   types.Transform   // if set, dominance is checked in the transformed space
+}
+
+// transformedEngine exposes only the Engine methods of an engine with a transform. Weights apply
+// to the stored coordinates rather than the transformed ones, so it is not a TopKEngine.
+type transformedEngine struct {
+	Engine
 }
 
 // DynamicSkyline creates a new dynamic skyline Engine and calculates the initial skyline.
 // DynamicSkyline returns an Engine that supports incremental skyline updates.
-func DynamicSkyline(points []Point, dims []string, prefs Preference, algo string) (Engine, error) {
+func DynamicSkyline(points []Point, dims []string, prefs Preference, algo string) (TopKEngine, error) {
 	e := &engine{
 		points: points,
 		dims:   dims,
@@ -41,9 +59,47 @@ func DynamicSkyline(points []Point, dims []string, prefs Preference, algo string
 	return e, nil
 }
 
+// DynamicConstrainedSkyline creates a new dynamic skyline Engine that maintains the skyline of the
+// points satisfying constraints. Points outside the ranges are kept in the dataset but never enter
+// the skyline, and never prune points inside the ranges.
+func DynamicConstrainedSkyline(points []Point, dims []string, prefs Preference, constraints types.Constraints, algo string) (TopKEngine, error) {
+	e := &engine{
+		points:      points,
+		dims:        dims,
+		prefs:       prefs,
+		algo:        algo,
+		constraints: constraints,
+	}
+	result, err := ConstrainedSkyline(points, dims, prefs, constraints, algo)
+	if err != nil {
+		return nil, err
+	}
+	e.skyline = result
+	return e, nil
+}
+
+// DynamicTransformedSkyline creates a new dynamic skyline Engine that maintains the skyline of
+// points in the space defined by t, as computed by TransformedSkyline; prefs refers to the
+// transformed dimensions. Incremental and batch updates compare transformed coordinates on the
+// fly, so the engine never holds a transformed copy of the points.
+func DynamicTransformedSkyline(points []Point, dims []string, prefs Preference, t Transform) (Engine, error) {
+	e := &engine{
+		points:    points,
+		dims:      dims,
+		prefs:     prefs,
+		transform: t,
+	}
+	result, err := TransformedSkyline(points, prefs, t)
+	if err != nil {
+		return nil, err
+	}
+	e.skyline = result
+	return transformedEngine{e}, nil
+}
+
 // DynamicSkylineRaw creates a new dynamic skyline Engine using the provided points as the initial set, skipping skyline computation.
 // If algo is empty, it defaults to "bnl" for later batch operations. This is useful for batch insertion or when the dataset is already known to be the skyline.
-func DynamicSkylineRaw(points []Point, dims []string, prefs Preference, algo string) Engine {
+func DynamicSkylineRaw(points []Point, dims []string, prefs Preference, algo string) TopKEngine {
 	if algo == "" {
 		algo = "bnl"
 	}
@@ -59,6 +115,9 @@ func DynamicSkylineRaw(points []Point, dims []string, prefs Preference, algo str
 // Insert adds a new point and updates the skyline incrementally.
 func (e *engine) Insert(p Point) {
 	e.points = append(e.points, p)
+	if !utilities.Satisfies(p, e.constraints) {
+		return
+	}
 
 	// Optimized BNL: update skyline incrementally
 	dominated := false
@@ -66,7 +125,7 @@ func (e *engine) Insert(p Point) {
 
 	// Check if new point is dominated by any current skyline point
 	for _, s := range e.skyline {
-		if utilities.DominatesEpsilon(s, p, e.prefs, 0) {
+		if e.dominates(s, p) {
 			dominated = true
 			break
 		}
@@ -79,7 +138,7 @@ func (e *engine) Insert(p Point) {
 
 	// New point is not dominated, add it to skyline and remove any skyline points it dominates
 	for _, s := range e.skyline {
-		if utilities.DominatesEpsilon(p, s, e.prefs, 0) {
+		if e.dominates(p, s) {
 			// p dominates s, so s is not in new skyline
 			continue
 		}
@@ -142,13 +201,13 @@ func (e *engine) Delete(p Point) {
 				break
 			}
 		}
-		if found {
+		if found || !utilities.Satisfies(candidate, e.constraints) {
 			continue
 		}
 		// Check if candidate is dominated by any skyline point
 		dominated := false
 		for _, s := range updatedSkyline {
-			if utilities.DominatesEpsilon(s, candidate, e.prefs, 0) {
+			if e.dominates(s, candidate) {
 				dominated = true
 				break
 			}
@@ -159,7 +218,7 @@ func (e *engine) Delete(p Point) {
 		// Candidate is not dominated, add to skyline and remove any skyline points it dominates
 		var newSkyline []Point
 		for _, s := range updatedSkyline {
-			if utilities.DominatesEpsilon(candidate, s, e.prefs, 0) {
+			if e.dominates(candidate, s) {
 				continue
 			}
 			newSkyline = append(newSkyline, s)
@@ -175,19 +234,55 @@ func (e *engine) Skyline() []Point {
 	return e.skyline
 }
 
+// TopK returns the k stored points with the highest weighted utility, as computed by the
+// package-level TopK. With constraints, only points inside the ranges are ranked.
+//
+// The k-skyband is not maintained between calls: every call presorts all n stored points and
+// counts dominators against the band, up to n * b comparisons for a k-skyband of b points. Cache
+// the result if the data changes less often than it is queried.
+func (e *engine) TopK(weights []float64, k int) ([]Point, error) {
+	candidates := e.points
+	if e.constraints != nil {
+		candidates = nil
+		for _, p := range e.points {
+			if utilities.Satisfies(p, e.constraints) {
+				candidates = append(candidates, p)
+			}
+		}
+	}
+	return TopK(candidates, e.prefs, weights, k)
+}
+
 // InsertBatch adds multiple new points and updates the skyline using the configured algorithm (default BNL).
 // All new points are considered together with the current skyline, and only the non-dominated points are kept.
 func (e *engine) InsertBatch(points []Point) {
 	e.points = append(e.points, points...)
 	candidates := append(append([]Point(nil), e.skyline...), points...)
-	skyline, err := Skyline(candidates, e.dims, e.prefs, e.algo)
+	skyline, err := e.compute(candidates, e.algo)
 	if err != nil {
 		// fallback: use BNL if the configured algorithm fails
-		skyline, _ = Skyline(candidates, e.dims, e.prefs, "bnl")
+		skyline, _ = e.compute(candidates, "bnl")
 	}
 	e.skyline = skyline
 }
 
+// compute runs a static skyline algorithm over points with the engine's constraints, or with
+// TransformedSkyline if the engine has a transform.
+func (e *engine) compute(points []Point, algo string) ([]Point, error) {
+	if e.transform != nil {
+		return TransformedSkyline(points, e.prefs, e.transform)
+	}
+	return ConstrainedSkyline(points, e.dims, e.prefs, e.constraints, algo)
+}
+
+// dominates reports whether a dominates b, in the transformed space if the engine has a transform.
+func (e *engine) dominates(a, b Point) bool {
+	if e.transform != nil {
+		return utilities.DominatesTransform(a, b, e.prefs, e.transform, 0)
+	}
+	return utilities.DominatesEpsilon(a, b, e.prefs, 0)
+}
+
 // equalPoint compares two points for equality.
 func equalPoint(a, b Point) bool {
 	if len(a) != len(b) {
@@ -133,4 +133,88 @@ func TestDynamicUpdateSkyline(t *testing.T) {
     if len(after2) != 1 || !equalPoint(after2[0], Point{2000, 2000}) {
         t.Errorf("Skyline changed after updating non-skyline point")
     }
-}
\ No newline at end of file
+}
+
+func TestDynamicConstrainedSkyline(t *testing.T) {
+	// price (Min), weight (Min); only price between 200 and 800 and weight at most 2
+	points := []Point{{300, 1.8}, {500, 1.2}, {150, 1.0}, {700, 2.5}}
+	constraints := Constraints{{Min: 200, Max: 800}, {Min: 0, Max: 2}}
+	engine, err := DynamicConstrainedSkyline(points, []string{"price", "weight"}, Preference{Min, Min}, constraints, "bnl")
+	if err != nil {
+		t.Fatalf("engine creation failed: %v", err)
+	}
+	if sky := engine.Skyline(); len(sky) != 2 {
+		t.Fatalf("expected {300,1.8} and {500,1.2}, got %v", sky)
+	}
+
+	// Outside the price range: must neither enter the skyline nor prune it
+	engine.Insert(Point{100, 0.5})
+	if sky := engine.Skyline(); len(sky) != 2 {
+		t.Errorf("point outside the constraints changed the skyline: %v", sky)
+	}
+
+	// Inside the ranges and dominating both
+	engine.Insert(Point{250, 1.0})
+	if sky := engine.Skyline(); len(sky) != 1 || !equalPoint(sky[0], Point{250, 1.0}) {
+		t.Errorf("expected only {250,1.0}, got %v", sky)
+	}
+
+	// Deleting it must restore only the constrained points
+	engine.Delete(Point{250, 1.0})
+	if sky := engine.Skyline(); len(sky) != 2 {
+		t.Errorf("expected the two constrained points back after delete, got %v", sky)
+	}
+}
+
+func TestEngineTopK(t *testing.T) {
+	points := []Point{{100, 4}, {80, 3}, {120, 5}, {90, 1}}
+	prefs := Preference{Min, Max}
+	e, err := DynamicSkyline(points, nil, prefs, "bnl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	top, err := e.TopK([]float64{1, 0}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(top) != 2 || !equalPoint(top[0], Point{80, 3}) || !equalPoint(top[1], Point{90, 1}) {
+		t.Errorf("expected the two cheapest points, got %v", top)
+	}
+	e.Insert(Point{70, 2})
+	if top, _ := e.TopK([]float64{1, 0}, 1); len(top) != 1 || !equalPoint(top[0], Point{70, 2}) {
+		t.Errorf("expected the inserted point to rank first, got %v", top)
+	}
+	if _, err := e.TopK([]float64{1}, 1); err == nil {
+		t.Errorf("expected an error for a weight count mismatch")
+	}
+}
+
+func TestDynamicTransformedSkyline(t *testing.T) {
+	// weight (kg), price: the laptops closest to 1.5kg and $900
+	laptops := []Point{{1.2, 1100}, {1.5, 1200}, {1.4, 950}, {2.0, 900}}
+	q := QueryTransform{Query: Point{1.5, 900}}
+	e, err := DynamicTransformedSkyline(laptops, nil, Preference{Min, Min}, q)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sky := e.Skyline(); len(sky) != 3 {
+		t.Errorf("expected 3 points in the dynamic skyline, got %v", sky)
+	}
+
+	// Distances (0, 10) beat {1.5, 1200} and {1.4, 950}; {2.0, 900} keeps its exact price
+	e.Insert(Point{1.5, 910})
+	if sky := e.Skyline(); len(sky) != 2 {
+		t.Errorf("expected the inserted point and {2.0, 900}, got %v", sky)
+	}
+	e.Delete(Point{1.5, 910})
+	if sky := e.Skyline(); len(sky) != 3 {
+		t.Errorf("expected the original skyline back after delete, got %v", sky)
+	}
+	e.(transformedEngine).Engine.(*engine).InsertBatch([]Point{{1.5, 910}, {1.5, 905}})
+	if sky := e.Skyline(); len(sky) != 2 || !equalPoint(sky[0], Point{2.0, 900}) && !equalPoint(sky[1], Point{2.0, 900}) {
+		t.Errorf("expected {1.5, 905} and {2.0, 900} after a batch insert, got %v", sky)
+	}
+	if _, ok := e.(TopKEngine); ok {
+		t.Errorf("expected a transformed engine not to implement TopKEngine")
+	}
+}
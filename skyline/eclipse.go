@@ -0,0 +1,53 @@
+package skyline
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// maxEclipseRatios bounds len(ratios), since every point is scored at 2^len(ratios) weightings.
+const maxEclipseRatios = 16
+
+// Eclipse returns the points that are not eclipse-dominated: no other point scores at least as
+// well under every linear weighting allowed by ratios and strictly better under one.
+// The last non-ignored dimension is the reference with weight 1, and ratios[i] bounds the weight of
+// the i-th other non-ignored dimension relative to it, so len(ratios) must be one less than the
+// number of non-ignored dimensions. The result lies between the top-1 points (Low == High for
+// every ratio) and the skyline (very wide ratio ranges). Categorical dimensions cannot be weighted
+// and are rejected.
+func Eclipse(points []Point, prefs Preference, ratios []WeightRatio) ([]Point, error) {
+	if err := validateRatios(prefs, ratios); err != nil {
+		return nil, err
+	}
+	return algorithms.Eclipse(points, prefs, ratios), nil
+}
+
+func validateRatios(prefs Preference, ratios []WeightRatio) error {
+	if err := requireNumeric(prefs, "cannot be weighted"); err != nil {
+		return err
+	}
+	active := 0
+	for _, order := range prefs {
+		if order != types.Ignore {
+			active++
+		}
+	}
+	if active == 0 {
+		return fmt.Errorf("no dimensions to compare")
+	}
+	if len(ratios) != active-1 {
+		return fmt.Errorf("expected %d weight ratios, got %d", active-1, len(ratios))
+	}
+	if len(ratios) > maxEclipseRatios {
+		return fmt.Errorf("eclipse supports at most %d weight ratios, got %d", maxEclipseRatios, len(ratios))
+	}
+	for i, r := range ratios {
+		if r.Low < 0 || r.Low > r.High || math.IsInf(r.High, 0) || math.IsNaN(r.Low) || math.IsNaN(r.High) {
+			return fmt.Errorf("invalid weight ratio %d: [%v, %v]", i, r.Low, r.High)
+		}
+	}
+	return nil
+}
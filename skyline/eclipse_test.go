@@ -0,0 +1,38 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestEclipse(t *testing.T) {
+	// price (Min), rating (Max); price weighs 0.5 to 2 times as much as rating
+	points := []Point{{1, 4}, {2, 10}, {1, 1}, {3, 3}}
+	prefs := Preference{Min, Max}
+	got, err := Eclipse(points, prefs, []WeightRatio{{Low: 0.5, High: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || !equalPoint(got[0], Point{2, 10}) {
+		t.Errorf("expected only {2, 10}, got %v", got)
+	}
+
+	invalid := [][]WeightRatio{
+		nil,
+		{{Low: 2, High: 1}},
+		{{Low: -1, High: 1}},
+		{{Low: 1, High: 1}, {Low: 1, High: 1}},
+	}
+	for _, ratios := range invalid {
+		if _, err := Eclipse(points, prefs, ratios); err == nil {
+			t.Errorf("expected an error for ratios %v", ratios)
+		}
+	}
+
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := Eclipse(points, Preference{Min, Categorical(po)}, []WeightRatio{{Low: 1, High: 2}}); err == nil {
+		t.Errorf("expected an error for a categorical dimension")
+	}
+}
@@ -0,0 +1,27 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// GroupSkyline returns the Pareto-optimal groups of k points, such as teams or portfolios. Each
+// group is summarized by aggregating its points per dimension with aggs (sum, min or max), and
+// a group is returned if no other group of k points has a dominating aggregate.
+//
+// Only groups that contain every dominator of their members are built, drawn from the points
+// dominated by fewer than k others, so far fewer than C(n, k) groups are enumerated. With min or
+// max aggregates, groups that tie exactly with a returned group may be omitted.
+func GroupSkyline(points []Point, prefs Preference, aggs []Aggregate, k int) ([]Group, error) {
+	if len(aggs) != len(prefs) {
+		return nil, fmt.Errorf("expected %d aggregates, got %d", len(prefs), len(aggs))
+	}
+	if k <= 0 || k > len(points) {
+		return nil, fmt.Errorf("group size %d outside [1, %d]", k, len(points))
+	}
+	if err := requireNumeric(prefs, "cannot be aggregated"); err != nil {
+		return nil, err
+	}
+	return algorithms.GroupSkyline(points, prefs, aggs, k), nil
+}
@@ -0,0 +1,36 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestGroupSkyline(t *testing.T) {
+	// salary (Min, summed), skill (Max, summed)
+	players := []Point{{100, 9}, {60, 6}, {50, 4}, {90, 5}, {40, 2}}
+	prefs := Preference{Min, Max}
+	groups, err := GroupSkyline(players, prefs, []Aggregate{SumAggregate, SumAggregate}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Of the 10 pairs, the Pareto-optimal sums are 160/15, 150/13, 140/11, 110/10, 100/8 and 90/6,
+	// none of them using {90, 5}
+	if len(groups) != 6 {
+		t.Fatalf("expected 6 groups, got %v", groups)
+	}
+	for _, g := range groups {
+		if len(g.Points) != 2 {
+			t.Errorf("expected groups of 2, got %v", g.Points)
+		}
+		for _, p := range g.Points {
+			if equalPoint(p, Point{90, 5}) {
+				t.Errorf("every group with {90, 5} is beaten, got %v", g)
+			}
+		}
+	}
+	if _, err := GroupSkyline(players, prefs, []Aggregate{SumAggregate}, 2); err == nil {
+		t.Errorf("expected an error for missing aggregates")
+	}
+	if _, err := GroupSkyline(players, prefs, []Aggregate{SumAggregate, MinAggregate}, 6); err == nil {
+		t.Errorf("expected an error for a group larger than the dataset")
+	}
+}
@@ -0,0 +1,13 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// IncompleteSkyline computes the skyline of points with missing values, marked as math.NaN().
+// Two points are compared only on the dimensions both of them have, so no sentinel values are
+// needed. This relation is not transitive, which makes Skyline incorrect on such data; the
+// bucket-based ISkyline algorithm used here handles it.
+func IncompleteSkyline(points []Point, prefs Preference) []Point {
+	return algorithms.IncompleteSkyline(points, prefs)
+}
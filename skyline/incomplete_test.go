@@ -0,0 +1,22 @@
+package skyline
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIncompleteSkyline(t *testing.T) {
+	// price (Min), rating (Max), battery hours (Max); rating or battery may be unknown
+	nan := math.NaN()
+	points := []Point{
+		{400, 4.5, 8},
+		{450, nan, 10},
+		{500, 4.0, nan}, // dominated by {400, 4.5, 8} on price and rating
+		{460, nan, 9},   // dominated by {450, NaN, 10} on price and battery
+	}
+	prefs := Preference{Min, Max, Max}
+	result := IncompleteSkyline(points, prefs)
+	if len(result) != 2 || result[0][0] != 400 || result[1][0] != 450 {
+		t.Errorf("expected {400, 4.5, 8} and {450, NaN, 10}, got %v", result)
+	}
+}
@@ -0,0 +1,16 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// SkylineJoin returns the skyline of combine(l, r) over all pairs of left and right points whose
+// joinKey values are equal, such as a flight and a hotel for the same destination combined into a
+// bundle with summed price and averaged rating. prefs applies to left, right and joined points alike.
+//
+// combine must be monotone in every dimension: a better input never makes the joined point worse.
+// Each join group is first reduced to the skyline of either side, and groups whose best possible
+// combination is already dominated are skipped, so the full join is never materialized.
+func SkylineJoin[K comparable](left, right []Point, joinKey func(Point) K, combine func(l, r Point) Point, prefs Preference) []Point {
+	return algorithms.SkylineJoin(left, right, joinKey, combine, prefs)
+}
@@ -0,0 +1,22 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestSkylineJoin(t *testing.T) {
+	// destination, price (Min), rating (Max)
+	flights := []Point{{1, 300, 4}, {1, 350, 3}, {2, 200, 3}}
+	hotels := []Point{{1, 100, 5}, {1, 120, 4}, {2, 400, 2}, {3, 50, 5}}
+	prefs := Preference{Ignore, Min, Max}
+	combine := func(f, h Point) Point {
+		return Point{f[0], f[1] + h[1], (f[2] + h[2]) / 2}
+	}
+	key := func(p Point) int { return int(p[0]) }
+
+	result := SkylineJoin(flights, hotels, key, combine, prefs)
+	// {1, 400, 4.5} dominates every other bundle to destination 1 and {2, 600, 2.5}
+	if len(result) != 1 || !equalPoint(result[0], Point{1, 400, 4.5}) {
+		t.Errorf("expected only {1, 400, 4.5}, got %v", result)
+	}
+}
@@ -0,0 +1,14 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// KDominantSkyline returns the points that are not k-dominated by any other point.
+// A point k-dominates another if it is at least as good in some k of the non-ignored
+// dimensions and strictly better in one of them. With k equal to the number of non-ignored
+// dimensions this is the ordinary skyline; smaller k shrinks the result on high-dimensional data,
+// possibly down to nothing since k-dominance can be cyclic.
+func KDominantSkyline(points []Point, prefs Preference, k int) []Point {
+	return algorithms.KDominantSkyline(points, prefs, k)
+}
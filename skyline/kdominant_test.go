@@ -0,0 +1,17 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestKDominantSkyline(t *testing.T) {
+	points := []Point{{1, 5, 5}, {5, 1, 5}, {2, 2, 2}, {5, 5, 1}}
+	prefs := Preference{Min, Min, Min}
+	if full := KDominantSkyline(points, prefs, 3); len(full) != 4 {
+		t.Errorf("3-dominant skyline in 3D should be the full skyline, got %v", full)
+	}
+	result := KDominantSkyline(points, prefs, 2)
+	if len(result) != 1 || !equalPoint(result[0], Point{2, 2, 2}) {
+		t.Errorf("expected only {2,2,2} in the 2-dominant skyline, got %v", result)
+	}
+}
@@ -0,0 +1,39 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// SkylineLayers returns points grouped into successive Pareto fronts (non-dominated sorting):
+// layer 1 is the skyline, layer 2 is the skyline of what remains, and so on.
+// At most maxLayers layers are returned; maxLayers <= 0 returns all of them.
+func SkylineLayers(points []Point, prefs Preference, maxLayers int) [][]Point {
+	return algorithms.SkylineLayers(points, prefs, maxLayers)
+}
+
+// LayerRanks returns the 1-based layer (dominance rank) of each point, in input order.
+// Points beyond maxLayers get rank 0; maxLayers <= 0 ranks every point.
+func LayerRanks(points []Point, prefs Preference, maxLayers int) []int {
+	return algorithms.LayerRanks(points, prefs, maxLayers)
+}
+
+// CrowdingDistance returns the NSGA-II crowding distance of each point in a front.
+// Boundary points in any dimension get +Inf. Categorical dimensions have no distance between
+// categories and are rejected.
+func CrowdingDistance(front []Point, prefs Preference) ([]float64, error) {
+	if err := requireNumeric(prefs, "has no crowding distance"); err != nil {
+		return nil, err
+	}
+	return algorithms.CrowdingDistance(front, prefs), nil
+}
+
+// SelectSurvivors selects n points by filling from successive Pareto fronts and breaking ties
+// in the last, partially taken front by decreasing crowding distance (NSGA-II environmental
+// selection). It returns indices into points so callers can map back to their individuals.
+// Categorical dimensions are rejected, as for CrowdingDistance.
+func SelectSurvivors(points []Point, prefs Preference, n int) ([]int, error) {
+	if err := requireNumeric(prefs, "has no crowding distance"); err != nil {
+		return nil, err
+	}
+	return algorithms.SelectSurvivors(points, prefs, n), nil
+}
@@ -0,0 +1,65 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestSkylineLayers(t *testing.T) {
+	points := []Point{{400, 10}, {500, 12}, {300, 9}, {450, 11}, {420, 15}, {460, 14}, {390, 8}}
+	prefs := Preference{Min, Max}
+
+	layers := SkylineLayers(points, prefs, 0)
+	sky, _ := Skyline(points, nil, prefs, "bnl")
+	if len(layers) == 0 || len(layers[0]) != len(sky) {
+		t.Fatalf("first layer should be the skyline %v, got %v", sky, layers)
+	}
+	total := 0
+	for _, layer := range layers {
+		total += len(layer)
+	}
+	if total != len(points) {
+		t.Errorf("layers should partition all %d points, got %d", len(points), total)
+	}
+
+	ranks := LayerRanks(points, prefs, 0)
+	for i, p := range points {
+		found := false
+		for _, q := range layers[ranks[i]-1] {
+			if equalPoint(p, q) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("point %v has rank %d but is not in that layer", p, ranks[i])
+		}
+	}
+}
+
+func TestSelectSurvivors(t *testing.T) {
+	population := []Point{{0, 4}, {1, 3}, {3, 1}, {4, 0}, {2, 2.5}, {5, 5}}
+	prefs := Preference{Min, Min}
+	survivors, err := SelectSurvivors(population, prefs, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(survivors) != 4 {
+		t.Fatalf("expected 4 survivors, got %v", survivors)
+	}
+	for _, i := range survivors {
+		if i == 5 {
+			t.Errorf("dominated point {5,5} should not survive: %v", survivors)
+		}
+	}
+	dist, err := CrowdingDistance([]Point{{0, 4}, {1, 3}, {3, 1}, {4, 0}}, prefs)
+	if err != nil || len(dist) != 4 || dist[1] != dist[2] {
+		t.Errorf("expected symmetric crowding distances, got %v (%v)", dist, err)
+	}
+
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := SelectSurvivors(population, Preference{Min, Categorical(po)}, 4); err == nil {
+		t.Errorf("expected an error for a categorical dimension")
+	}
+}
@@ -0,0 +1,57 @@
+package skyline
+
+import (
+	"math"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// earthRadiusKm is the mean Earth radius used by GreatCircleDistance.
+const earthRadiusKm = 6371.0088
+
+// MetricSkyline returns the objects that no other object beats for every query: o dominates o' if
+// it is at least as close to every query under metric and strictly closer to one. It is the
+// dynamic skyline (see QuerySkyline) of data without coordinates, such as strings under
+// EditDistance; for points, TransformedSkyline with a MetricTransform gives the same result with
+// the same pruning.
+//
+// metric must be a metric, in particular it must satisfy the triangle inequality, which is used
+// to discard objects before all of their distances to the queries have been computed.
+func MetricSkyline[O any](objects, queries []O, metric func(a, b O) float64) []O {
+	idx := algorithms.MetricSkyline(objects, queries, metric)
+	result := make([]O, len(idx))
+	for i, j := range idx {
+		result[i] = objects[j]
+	}
+	return result
+}
+
+// GreatCircleDistance returns the haversine distance in kilometres between two (latitude,
+// longitude) points given in degrees.
+func GreatCircleDistance(a, b Point) float64 {
+	lat1, lat2 := a[0]*math.Pi/180, b[0]*math.Pi/180
+	dLat, dLon := lat2-lat1, (b[1]-a[1])*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// EditDistance returns the Levenshtein distance between a and b, counted in runes.
+func EditDistance(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	prev, cur := make([]int, len(rb)+1), make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return float64(prev[len(rb)])
+}
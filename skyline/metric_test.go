@@ -0,0 +1,56 @@
+package skyline
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMetricSkylineEditDistance(t *testing.T) {
+	words := []string{"cat", "cart", "car", "dog", "cot"}
+	got := MetricSkyline(words, []string{"cat", "car"}, EditDistance)
+	slices.Sort(got)
+	// cat (0, 1) and car (1, 0) dominate cart (1, 1), cot (1, 2) and dog (3, 3)
+	if !slices.Equal(got, []string{"car", "cat"}) {
+		t.Errorf("expected [car cat], got %v", got)
+	}
+}
+
+func TestMetricSkylineGreatCircle(t *testing.T) {
+	// (latitude, longitude) of hotels and of the two venues of a trip
+	hotels := []Point{{48.8566, 2.3522}, {51.5074, -0.1278}, {40.4168, -3.7038}, {50.8503, 4.3517}}
+	venues := []Point{{48.8566, 2.3522}, {51.5074, -0.1278}}
+	got := MetricSkyline(hotels, venues, GreatCircleDistance)
+	// Madrid is farther than Paris from both venues; Brussels is closer than Paris to London
+	if len(got) != 3 || slices.ContainsFunc(got, func(p Point) bool { return equalPoint(p, hotels[2]) }) {
+		t.Errorf("expected Paris, London and Brussels, got %v", got)
+	}
+	want, err := TransformedSkyline(hotels, Preference{Min, Min}, MetricTransform{Queries: venues, Metric: GreatCircleDistance})
+	if err != nil || len(want) != len(got) {
+		t.Errorf("expected MetricTransform to agree, got %v", want)
+	}
+	one := MetricTransform{Queries: venues[:1], Metric: GreatCircleDistance}
+	if _, err := TransformedSkyline(hotels, Preference{Min, Min}, one); err == nil {
+		t.Errorf("expected an error for more preferences than queries")
+	}
+}
+
+func TestGreatCircleDistance(t *testing.T) {
+	// Paris to London is about 344 km
+	d := GreatCircleDistance(Point{48.8566, 2.3522}, Point{51.5074, -0.1278})
+	if math.Abs(d-343.5) > 2 {
+		t.Errorf("expected about 344 km, got %v", d)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want float64
+	}{{"kitten", "sitting", 3}, {"", "abc", 3}, {"héllo", "hello", 1}, {"same", "same", 0}}
+	for _, c := range cases {
+		if got := EditDistance(c.a, c.b); got != c.want {
+			t.Errorf("EditDistance(%q, %q) = %v, expected %v", c.a, c.b, got, c.want)
+		}
+	}
+}
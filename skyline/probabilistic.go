@@ -0,0 +1,61 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// SkylineProbabilities returns the probability of each uncertain object being in the skyline,
+// assuming objects independently take one of their instances with the given probabilities.
+// Instances are pruned with per-object minimum bounding rectangles.
+func SkylineProbabilities(objects []UncertainPoint, prefs Preference) ([]float64, error) {
+	if err := validateUncertain(objects); err != nil {
+		return nil, err
+	}
+	return algorithms.SkylineProbabilities(objects, prefs), nil
+}
+
+// ProbabilisticSkyline returns the objects whose skyline probability is at least threshold, in
+// input order. Unlike a skyline over averaged instances, the result is stable under noise: an
+// object only drops out when it is unlikely to be Pareto-optimal. Objects that cannot reach the
+// threshold are abandoned early.
+func ProbabilisticSkyline(objects []UncertainPoint, prefs Preference, threshold float64) ([]UncertainPoint, error) {
+	if err := validateUncertain(objects); err != nil {
+		return nil, err
+	}
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("threshold %v outside [0, 1]", threshold)
+	}
+	idx := algorithms.ProbabilisticSkyline(objects, prefs, threshold)
+	result := make([]UncertainPoint, len(idx))
+	for i, j := range idx {
+		result[i] = objects[j]
+	}
+	return result, nil
+}
+
+func validateUncertain(objects []UncertainPoint) error {
+	for i, o := range objects {
+		if len(o.Instances) == 0 {
+			return fmt.Errorf("object %d has no instances", i)
+		}
+		if o.Probs == nil {
+			continue
+		}
+		if len(o.Probs) != len(o.Instances) {
+			return fmt.Errorf("object %d has %d instances but %d probabilities", i, len(o.Instances), len(o.Probs))
+		}
+		total := 0.0
+		for _, p := range o.Probs {
+			if p < 0 {
+				return fmt.Errorf("object %d has a negative probability %v", i, p)
+			}
+			total += p
+		}
+		if total > 1+1e-9 {
+			return fmt.Errorf("object %d has probabilities summing to %v", i, total)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package skyline
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProbabilisticSkyline(t *testing.T) {
+	// Two sensors measuring latency (Min) and throughput (Max) with noise
+	objects := []UncertainPoint{
+		{Instances: []Point{{10, 100}, {12, 90}}},
+		{Instances: []Point{{11, 95}, {20, 50}}, Probs: []float64{0.8, 0.2}},
+		{Instances: []Point{{30, 40}}},
+	}
+	prefs := Preference{Min, Max}
+	probs, err := SkylineProbabilities(objects, prefs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// {12, 90} is dominated by {11, 95} (0.8) and {11, 95} by {10, 100} (0.5);
+	// {20, 50} is dominated by every instance of the first object
+	expected := []float64{0.5 + 0.5*0.2, 0.8 * 0.5, 0}
+	for i := range expected {
+		if math.Abs(probs[i]-expected[i]) > 1e-12 {
+			t.Errorf("object %d: expected %v, got %v", i, expected[i], probs[i])
+		}
+	}
+
+	result, err := ProbabilisticSkyline(objects, prefs, 0.3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 {
+		t.Errorf("expected the first two objects, got %v", result)
+	}
+
+	if _, err := SkylineProbabilities([]UncertainPoint{{Instances: []Point{{1, 1}}, Probs: []float64{0.7, 0.3}}}, prefs); err == nil {
+		t.Errorf("expected an error for mismatched probabilities")
+	}
+	if _, err := ProbabilisticSkyline(objects, prefs, 1.5); err == nil {
+		t.Errorf("expected an error for a threshold above 1")
+	}
+}
@@ -0,0 +1,96 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// QuerySkyline computes the dynamic skyline of points relative to the query point q:
+// each coordinate is replaced by |p[i] - q[i]|, which is minimized regardless of the Min/Max
+// preference, and Ignore dimensions are skipped. Distances are computed on the fly, the dataset
+// is not copied. q and every point must have len(prefs) dimensions, and categorical dimensions
+// have no distance to q and are rejected.
+func QuerySkyline(points []Point, prefs Preference, q Point) ([]Point, error) {
+	if err := checkQueryDims(points, prefs, q); err != nil {
+		return nil, err
+	}
+	return TransformedSkyline(points, algorithms.MinimizeAll(prefs), QueryTransform{Query: q})
+}
+
+// TransformedSkyline computes the skyline of points in the space defined by t, where prefs refers
+// to the transformed dimensions and must have t.Dims() entries. Transformed coordinates are
+// computed on the fly while points are presorted and filtered with a BNL window, so no transformed
+// copy of the data is created. A MetricTransform with every dimension set to Min is computed as a
+// MetricSkyline, whose triangle-inequality pruning skips distance computations.
+func TransformedSkyline(points []Point, prefs Preference, t Transform) ([]Point, error) {
+	if err := checkTransformDims(prefs, t); err != nil {
+		return nil, err
+	}
+	return algorithms.TransformedSkyline(points, prefs, t, algorithms.BNLConfig{}), nil
+}
+
+// TransformedSkylineMatrix is TransformedSkyline over the rows of a matrix. It returns the indices
+// of the skyline rows of m.
+func TransformedSkylineMatrix(m Matrix, prefs Preference, t Transform) ([]int, error) {
+	if err := checkTransformDims(prefs, t); err != nil {
+		return nil, err
+	}
+	rows := make([]Point, m.Rows)
+	for i := range rows {
+		rows[i] = m.Row(i)
+	}
+	return algorithms.TransformedSkylineIndices(rows, prefs, t, algorithms.BNLConfig{}), nil
+}
+
+// TransformedSkylineView is TransformedSkyline computed over a materialized view: every point is
+// mapped once into an n x len(prefs) matrix of transformed coordinates, on which the selected
+// algorithm ("bnl", "sfs", "dnc" or "skytree") runs as it does on a Matrix. This opts in to a
+// transformed copy of the data in exchange for transforming each coordinate only once, which pays
+// off when the transform is expensive. If algo is empty, defaults to "bnl".
+func TransformedSkylineView(points []Point, prefs Preference, t Transform, algo string) ([]Point, error) {
+	if err := checkTransformDims(prefs, t); err != nil {
+		return nil, err
+	}
+	idx, err := runSkylineMatrix(algorithms.TransformView(points, len(prefs), t), prefs, algo)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Point, len(idx))
+	for i, j := range idx {
+		result[i] = points[j]
+	}
+	return result, nil
+}
+
+func checkTransformDims(prefs Preference, t Transform) error {
+	if t.Dims() != len(prefs) {
+		return fmt.Errorf("transform has %d dimensions, expected %d", t.Dims(), len(prefs))
+	}
+	return nil
+}
+
+// checkQueryDims validates the points and query of a dynamic or reverse skyline query.
+func checkQueryDims(points []Point, prefs Preference, q Point) error {
+	if len(q) != len(prefs) {
+		return fmt.Errorf("query has %d dimensions, expected %d", len(q), len(prefs))
+	}
+	for i, p := range points {
+		if len(p) != len(prefs) {
+			return fmt.Errorf("point %d has %d dimensions, expected %d", i, len(p), len(prefs))
+		}
+	}
+	return requireNumeric(prefs, "has no distance to the query")
+}
+
+// ReverseSkyline returns the points whose dynamic skyline (see QuerySkyline) would contain q,
+// e.g. the customers for whom a new product q would be Pareto-optimal.
+// Candidates are pruned to the global skyline of q and verified with one window scan each,
+// instead of computing a dynamic skyline per point. As for QuerySkyline, q and every point must
+// have len(prefs) dimensions and categorical dimensions are rejected.
+func ReverseSkyline(points []Point, prefs Preference, q Point) ([]Point, error) {
+	if err := checkQueryDims(points, prefs, q); err != nil {
+		return nil, err
+	}
+	return algorithms.ReverseSkyline(points, prefs, q), nil
+}
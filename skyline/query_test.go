@@ -0,0 +1,106 @@
+package skyline
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestQuerySkyline(t *testing.T) {
+	// weight (kg), price: looking for a laptop close to 1.5kg and close to $900
+	laptops := []Point{{1.2, 1100}, {1.5, 1200}, {1.4, 950}, {2.0, 900}, {1.6, 880}, {2.5, 600}}
+	prefs := Preference{Min, Min}
+	result, err := QuerySkyline(laptops, prefs, Point{1.5, 900})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range result {
+		if equalPoint(p, Point{2.5, 600}) || equalPoint(p, Point{1.2, 1100}) {
+			t.Errorf("%v is farther from the query than {1.6,880} in both dimensions", p)
+		}
+	}
+	if len(result) != 3 {
+		t.Errorf("expected 3 points in the dynamic skyline, got %v", result)
+	}
+
+	for _, algo := range []string{"bnl", "sfs", "dnc", "skytree"} {
+		same, err := TransformedSkylineView(laptops, prefs, QueryTransform{Query: Point{1.5, 900}}, algo)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(same) != len(result) {
+			t.Errorf("%s TransformedSkylineView with a QueryTransform should match QuerySkyline: %v vs %v", algo, same, result)
+		}
+	}
+	if _, err := TransformedSkylineView(laptops, prefs, QueryTransform{Query: Point{1.5, 900}}, "unknown"); err == nil {
+		t.Errorf("expected an error for an unknown algorithm")
+	}
+}
+
+func TestQuerySkylineDims(t *testing.T) {
+	laptops := []Point{{1.2, 1100}, {1.5, 1200}}
+	prefs := Preference{Min, Min}
+	if _, err := QuerySkyline(laptops, prefs, Point{1.5}); err == nil {
+		t.Errorf("expected an error for a query with too few dimensions")
+	}
+	if _, err := QuerySkyline(append(laptops, Point{1.4}), prefs, Point{1.5, 900}); err == nil {
+		t.Errorf("expected an error for a point with too few dimensions")
+	}
+	if _, err := TransformedSkyline(laptops, prefs, QueryTransform{Query: Point{1.5}}); err == nil {
+		t.Errorf("expected an error for a transform with too few dimensions")
+	}
+}
+
+func TestTransformedSkylineMatrix(t *testing.T) {
+	m := MatrixFromDataset(Dataset{{1.2, 1100}, {1.5, 1200}, {1.4, 950}, {2.0, 900}, {1.6, 880}, {2.5, 600}})
+	idx, err := TransformedSkylineMatrix(m, Preference{Min, Min}, QueryTransform{Query: Point{1.5, 900}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	slices.Sort(idx)
+	if !slices.Equal(idx, []int{1, 3, 4}) {
+		t.Errorf("expected rows [1 3 4], got %v", idx)
+	}
+}
+
+func TestReverseSkyline(t *testing.T) {
+	// customer preferences as (weight, price) ideals
+	customers := []Point{{1.0, 700}, {1.5, 900}, {2.5, 500}, {1.4, 950}}
+	prefs := Preference{Min, Min}
+
+	// A new laptop right next to the second customer's ideal
+	result, err := ReverseSkyline(customers, prefs, Point{1.5, 910})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	found := false
+	for _, p := range result {
+		if equalPoint(p, Point{1.5, 900}) {
+			found = true
+		}
+		if equalPoint(p, Point{2.5, 500}) {
+			t.Errorf("the existing {1.5,900} product is closer to {2.5,500} than the new one in both dimensions")
+		}
+	}
+	if !found {
+		t.Errorf("expected {1.5,900} in the reverse skyline, got %v", result)
+	}
+	if _, err := ReverseSkyline(customers, prefs, Point{1.5}); err == nil {
+		t.Errorf("expected an error for a query with too few dimensions")
+	}
+}
+
+func TestQuerySkylineCategorical(t *testing.T) {
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	points := []Point{{1, 0}, {2, 1}}
+	prefs := Preference{Min, Categorical(po)}
+	if _, err := QuerySkyline(points, prefs, Point{1, 0}); err == nil {
+		t.Errorf("expected QuerySkyline to reject a categorical dimension")
+	}
+	if _, err := ReverseSkyline(points, prefs, Point{1, 0}); err == nil {
+		t.Errorf("expected ReverseSkyline to reject a categorical dimension")
+	}
+}
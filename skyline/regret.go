@@ -0,0 +1,17 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// KRegret selects at most k points that minimize the maximum regret ratio over all linear
+// utilities consistent with prefs, and returns them with the regret ratio they achieve.
+// A regret ratio of 0.1 means that, whatever the user's weights, the best selected point scores
+// within 10% of the best point overall. Each dimension is rescaled to [0, 1] before scoring.
+// Categorical dimensions have no value to weight and are rejected.
+func KRegret(points []Point, prefs Preference, k int) ([]Point, float64, error) {
+	if err := requireNumeric(prefs, "cannot be weighted"); err != nil {
+		return nil, 0, err
+	}
+	return algorithms.KRegret(points, prefs, k)
+}
@@ -0,0 +1,31 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestKRegret(t *testing.T) {
+	points := []Point{{100, 1}, {200, 5}, {150, 3}, {300, 4}, {120, 1}}
+	prefs := Preference{Min, Max}
+	reps, regret, err := KRegret(points, prefs, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(reps) != 2 || regret < 0 || regret >= 1 {
+		t.Errorf("expected 2 points with a regret ratio in [0, 1), got %v, %v", reps, regret)
+	}
+	if _, regret, _ := KRegret(points, prefs, 3); regret != 0 {
+		t.Errorf("expected zero regret when k covers the skyline, got %v", regret)
+	}
+}
+
+func TestKRegretCategorical(t *testing.T) {
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	points := []Point{{100, 0}, {80, 1}}
+	if _, _, err := KRegret(points, Preference{Min, Categorical(po)}, 1); err == nil {
+		t.Errorf("expected KRegret to reject a categorical dimension")
+	}
+}
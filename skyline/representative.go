@@ -0,0 +1,31 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// RepresentativeSkyline picks at most k skyline points that best summarize the skyline.
+// Supported strategies:
+//   - "max-dominance": the k skyline points that together dominate the most non-skyline points
+//     (exact dynamic program in 2D, lazy greedy (1 - 1/e)-approximation in higher dimensions).
+//   - "distance": the k skyline points minimizing the maximum Euclidean distance from any skyline
+//     point to its nearest representative (exact dynamic program in 2D, greedy 2-approximation
+//     in higher dimensions).
+//
+// If strategy is empty, defaults to "max-dominance". "distance" rejects categorical dimensions,
+// which have no distance between categories.
+func RepresentativeSkyline(points []Point, prefs Preference, k int, strategy string) ([]Point, error) {
+	switch strategy {
+	case "", "max-dominance":
+		return algorithms.RepresentativeMaxDominance(points, prefs, k), nil
+	case "distance":
+		if err := requireNumeric(prefs, "has no distance between categories"); err != nil {
+			return nil, err
+		}
+		return algorithms.RepresentativeDistance(points, prefs, k), nil
+	default:
+		return nil, fmt.Errorf("unknown strategy: %s", strategy)
+	}
+}
@@ -0,0 +1,39 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestRepresentativeSkyline(t *testing.T) {
+	points := []Point{{1, 10}, {2, 8}, {3, 7}, {5, 5}, {8, 2}, {10, 1}, {6, 6}, {9, 9}}
+	prefs := Preference{Min, Min}
+	for _, strategy := range []string{"max-dominance", "distance"} {
+		reps, err := RepresentativeSkyline(points, prefs, 2, strategy)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", strategy, err)
+		}
+		if len(reps) == 0 || len(reps) > 2 {
+			t.Errorf("%s: expected up to 2 representatives, got %v", strategy, reps)
+		}
+		for _, r := range reps {
+			if equalPoint(r, Point{6, 6}) || equalPoint(r, Point{9, 9}) {
+				t.Errorf("%s: representative %v is not a skyline point", strategy, r)
+			}
+		}
+	}
+	if _, err := RepresentativeSkyline(points, prefs, 2, "random"); err == nil {
+		t.Errorf("expected an error for an unknown strategy")
+	}
+
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	categorical := Preference{Min, Categorical(po)}
+	if _, err := RepresentativeSkyline([]Point{{1, 0}, {2, 1}}, categorical, 1, "distance"); err == nil {
+		t.Errorf("expected the distance strategy to reject a categorical dimension")
+	}
+	if _, err := RepresentativeSkyline([]Point{{1, 0}, {2, 1}}, categorical, 1, "max-dominance"); err != nil {
+		t.Errorf("expected the max-dominance strategy to accept a categorical dimension, got %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// KSkyband returns all points dominated by fewer than k other points.
+// KSkyband(points, prefs, 1) is the skyline; larger k adds the "next best" alternatives.
+// It runs in a single sorted pass with a counting window rather than k skyline passes.
+func KSkyband(points []Point, prefs Preference, k int) []Point {
+	return algorithms.KSkyband(points, prefs, k)
+}
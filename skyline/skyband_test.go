@@ -0,0 +1,37 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestKSkyband(t *testing.T) {
+	points := []Point{
+		{400, 10},
+		{500, 12},
+		{300, 9},
+		{450, 11},
+		{420, 15},
+		{460, 14},
+		{390, 8},
+	}
+	prefs := Preference{Min, Max}
+
+	sky, err := Skyline(points, nil, prefs, "bnl")
+	if err != nil {
+		t.Fatalf("skyline failed: %v", err)
+	}
+	if band := KSkyband(points, prefs, 1); len(band) != len(sky) {
+		t.Errorf("1-skyband should equal the skyline: got %v, want %v", band, sky)
+	}
+
+	band := KSkyband(points, prefs, 2)
+	if len(band) <= len(sky) {
+		t.Errorf("2-skyband should extend the skyline, got %v", band)
+	}
+	if len(KSkyband(points, prefs, len(points))) != len(points) {
+		t.Errorf("n-skyband should contain every point")
+	}
+	if len(KSkyband(points, prefs, 0)) != 0 {
+		t.Errorf("0-skyband should be empty")
+	}
+}
@@ -0,0 +1,131 @@
+package skyline
+
+import (
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+	"github.com/gkoos/skyline/v2/types"
+)
+
+// Subspace selects a subset of dimensions as a bitmask: bit i set means dimension i is included.
+type Subspace = types.Subspace
+
+// SubspaceOf returns the Subspace containing the given dimensions.
+func SubspaceOf(dims ...int) Subspace {
+	return types.SubspaceOf(dims...)
+}
+
+// SkyCube holds the precomputed skyline of every non-empty subspace of the non-ignored
+// dimensions. Cuboids store indices into the original points, and cuboids equal to their
+// parent share its storage.
+type SkyCube struct {
+	points  []Point
+	cuboids map[Subspace][]int32
+}
+
+// NewSkyCube precomputes the skylines of all 2^d - 1 subspaces of the non-ignored dimensions,
+// sharing work top-down through the subspace lattice. It fails for more than
+// algorithms.MaxSkyCubeDims (20) non-ignored dimensions.
+func NewSkyCube(points []Point, prefs Preference) (*SkyCube, error) {
+	cuboids, err := algorithms.SkyCube(points, prefs)
+	if err != nil {
+		return nil, err
+	}
+	return &SkyCube{points: points, cuboids: cuboids}, nil
+}
+
+// Indices returns the indices of the skyline points of subspace s with a single lookup.
+// The slice is shared with the cube and must not be modified. It returns nil for subspaces
+// that are empty or include ignored dimensions.
+func (c *SkyCube) Indices(s Subspace) []int32 {
+	return c.cuboids[s]
+}
+
+// Skyline returns the skyline points of subspace s.
+func (c *SkyCube) Skyline(s Subspace) []Point {
+	idx := c.cuboids[s]
+	if idx == nil {
+		return nil
+	}
+	result := make([]Point, len(idx))
+	for i, j := range idx {
+		result[i] = c.points[j]
+	}
+	return result
+}
+
+// Subspaces returns every subspace stored in the cube, in no particular order.
+func (c *SkyCube) Subspaces() []Subspace {
+	result := make([]Subspace, 0, len(c.cuboids))
+	for s := range c.cuboids {
+		result = append(result, s)
+	}
+	return result
+}
+
+// Frequencies returns, for each point, the number of subspaces in which it is a skyline point
+// (its skyline frequency), in input order.
+func (c *SkyCube) Frequencies() []int {
+	return algorithms.SkylineFrequency(c.cuboids, len(c.points))
+}
+
+// MinimalSubspaces returns the subspaces in which points[i] is a skyline point but in none of
+// their proper subspaces, smallest first.
+func (c *SkyCube) MinimalSubspaces(i int) []Subspace {
+	return algorithms.MinimalSubspaces(c.cuboids, i)
+}
+
+// CompressedSkyCube is the compressed skycube: each point is stored only in its minimal subspaces
+// (see SkyCube.MinimalSubspaces) instead of in every subspace whose skyline contains it. Memory is
+// proportional to the number of (point, minimal subspace) pairs rather than to the total size of
+// all 2^d - 1 skylines. In exchange, a lookup is not O(1): it gathers the points stored in the
+// subspaces of s and keeps their skyline.
+type CompressedSkyCube struct {
+	points  []Point
+	prefs   Preference
+	cuboids map[Subspace][]int32
+}
+
+// NewCompressedSkyCube builds the compressed skycube of the non-ignored dimensions. It fails for
+// more than algorithms.MaxSkyCubeDims (20) non-ignored dimensions.
+func NewCompressedSkyCube(points []Point, prefs Preference) (*CompressedSkyCube, error) {
+	cuboids, err := algorithms.CompressedSkyCube(points, prefs)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressedSkyCube{points: points, prefs: prefs, cuboids: cuboids}, nil
+}
+
+// Indices returns the indices of the skyline points of subspace s. It returns nil for subspaces
+// that are empty or include ignored dimensions.
+func (c *CompressedSkyCube) Indices(s Subspace) []int32 {
+	return algorithms.CompressedSkyline(c.points, c.prefs, c.cuboids, s)
+}
+
+// Skyline returns the skyline points of subspace s.
+func (c *CompressedSkyCube) Skyline(s Subspace) []Point {
+	idx := c.Indices(s)
+	if idx == nil {
+		return nil
+	}
+	result := make([]Point, len(idx))
+	for i, j := range idx {
+		result[i] = c.points[j]
+	}
+	return result
+}
+
+// MinimalSubspaces returns the subspaces in which points[i] is a skyline point but in none of
+// their proper subspaces, smallest first. These are exactly the subspaces that store points[i].
+func (c *CompressedSkyCube) MinimalSubspaces(i int) []Subspace {
+	return algorithms.MinimalSubspaces(c.cuboids, i)
+}
+
+// SkylineFrequency returns, for each point, the number of the 2^d - 1 subspaces of the
+// non-ignored dimensions in which it is a skyline point. Use NewSkyCube directly to also
+// query minimal subspaces without recomputing the cube.
+func SkylineFrequency(points []Point, prefs Preference) ([]int, error) {
+	cube, err := NewSkyCube(points, prefs)
+	if err != nil {
+		return nil, err
+	}
+	return cube.Frequencies(), nil
+}
@@ -0,0 +1,76 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestSkyCube(t *testing.T) {
+	// price (Min), battery (Max), weight (Min)
+	points := []Point{{400, 10, 1.5}, {500, 12, 1.2}, {300, 9, 2.0}, {450, 11, 1.1}, {420, 15, 1.9}}
+	prefs := Preference{Min, Max, Min}
+	cube, err := NewSkyCube(points, prefs)
+	if err != nil {
+		t.Fatalf("NewSkyCube failed: %v", err)
+	}
+	if len(cube.Subspaces()) != 7 {
+		t.Errorf("expected 7 subspaces, got %d", len(cube.Subspaces()))
+	}
+
+	price := cube.Skyline(SubspaceOf(0))
+	if len(price) != 1 || !equalPoint(price[0], Point{300, 9, 2.0}) {
+		t.Errorf("price-only skyline should be the cheapest point, got %v", price)
+	}
+	full, _ := Skyline(points, nil, prefs, "bnl")
+	if got := cube.Skyline(SubspaceOf(0, 1, 2)); len(got) != len(full) {
+		t.Errorf("full-space cuboid should equal the skyline: got %v, want %v", got, full)
+	}
+	if cube.Skyline(SubspaceOf(3)) != nil {
+		t.Errorf("expected nil for a subspace outside the cube")
+	}
+}
+
+func TestCompressedSkyCube(t *testing.T) {
+	points := []Point{{400, 10, 1.5}, {500, 12, 1.2}, {300, 9, 2.0}, {450, 11, 1.1}, {420, 15, 1.9}}
+	prefs := Preference{Min, Max, Min}
+	cube, err := NewSkyCube(points, prefs)
+	if err != nil {
+		t.Fatalf("NewSkyCube failed: %v", err)
+	}
+	compressed, err := NewCompressedSkyCube(points, prefs)
+	if err != nil {
+		t.Fatalf("NewCompressedSkyCube failed: %v", err)
+	}
+	for _, s := range cube.Subspaces() {
+		if got, want := compressed.Skyline(s), cube.Skyline(s); len(got) != len(want) {
+			t.Errorf("subspace %b: got %v, want %v", s, got, want)
+		}
+	}
+	// {300, 9, 2.0} is the cheapest, so price alone is its only minimal subspace
+	if got := compressed.MinimalSubspaces(2); len(got) != 1 || got[0] != SubspaceOf(0) {
+		t.Errorf("expected [SubspaceOf(0)], got %v", got)
+	}
+	if compressed.Skyline(SubspaceOf(3)) != nil {
+		t.Errorf("expected nil for a subspace outside the cube")
+	}
+}
+
+func TestSkylineFrequency(t *testing.T) {
+	points := []Point{{1, 5}, {5, 1}, {2, 2}, {6, 6}}
+	prefs := Preference{Min, Min}
+	freq, err := SkylineFrequency(points, prefs)
+	if err != nil {
+		t.Fatalf("SkylineFrequency failed: %v", err)
+	}
+	// {1,5}: {0} and {0,1}; {5,1}: {1} and {0,1}; {2,2}: only {0,1}
+	want := []int{2, 2, 1, 0}
+	for i := range want {
+		if freq[i] != want[i] {
+			t.Errorf("point %v: expected frequency %d, got %d", points[i], want[i], freq[i])
+		}
+	}
+
+	cube, _ := NewSkyCube(points, prefs)
+	if m := cube.MinimalSubspaces(2); len(m) != 1 || m[0] != SubspaceOf(0, 1) {
+		t.Errorf("expected {2,2} to be minimal only in the full space, got %v", m)
+	}
+}
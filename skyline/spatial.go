@@ -0,0 +1,35 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// SpatialSkyline returns the points that no other point beats for every query location: p
+// dominates p' if it is at least as close to every query (Euclidean distance) and strictly closer
+// to one, and at least as good in the non-spatial dimensions of prefs.
+//
+// location names the x and y dimensions of the points, and queries are (x, y) points, e.g. the
+// homes of a team. The entries of prefs for the location dimensions are ignored; set every other
+// entry to Ignore for a purely spatial query. Dominance is checked against the vertices of the
+// convex hull of the queries only.
+func SpatialSkyline(points []Point, location [2]int, queries []Point, prefs Preference) ([]Point, error) {
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no query locations")
+	}
+	for i, q := range queries {
+		if len(q) != 2 {
+			return nil, fmt.Errorf("query %d has %d coordinates, expected 2", i, len(q))
+		}
+	}
+	if location[0] == location[1] || location[0] < 0 || location[1] < 0 {
+		return nil, fmt.Errorf("invalid location dimensions %v", location)
+	}
+	for i, p := range points {
+		if location[0] >= len(p) || location[1] >= len(p) {
+			return nil, fmt.Errorf("point %d has %d dimensions, location %v out of range", i, len(p), location)
+		}
+	}
+	return algorithms.SpatialSkyline(points, location, queries, prefs), nil
+}
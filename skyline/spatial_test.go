@@ -0,0 +1,37 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestSpatialSkyline(t *testing.T) {
+	// x, y, rating (Max)
+	stores := []Point{
+		{5, 5, 3},  // between both homes
+		{5, 6, 5},  // just as central, better rated
+		{20, 5, 5}, // farther from both homes than {5, 6, 5}, same rating
+		{0, 0, 1},  // at the first home
+	}
+	homes := []Point{{0, 0}, {10, 10}}
+
+	result, err := SpatialSkyline(stores, [2]int{0, 1}, homes, Preference{Ignore, Ignore, Ignore})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// {20, 5} is beaten by {5, 5}, with squared distances 50 < 425 and 50 < 125
+	if len(result) != 3 {
+		t.Errorf("expected 3 stores, got %v", result)
+	}
+
+	result, _ = SpatialSkyline(stores, [2]int{0, 1}, homes, Preference{Ignore, Ignore, Max})
+	if len(result) != 3 {
+		t.Errorf("expected 3 stores with ratings, got %v", result)
+	}
+
+	if _, err := SpatialSkyline(stores, [2]int{0, 1}, nil, Preference{Ignore, Ignore, Ignore}); err == nil {
+		t.Errorf("expected an error without query locations")
+	}
+	if _, err := SpatialSkyline(stores, [2]int{0, 3}, homes, Preference{Ignore, Ignore, Ignore}); err == nil {
+		t.Errorf("expected an error for a location outside the points")
+	}
+}
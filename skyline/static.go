@@ -3,8 +3,8 @@ package skyline
 import (
 	"fmt"
 
-	"github.com/gkoos/skyline/internal/algorithms"
-	"github.com/gkoos/skyline/types"
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+	"github.com/gkoos/skyline/v2/types"
 )
 
 // DNCConfig controls the configuration for the Divide & Conquer skyline algorithm.
@@ -27,20 +27,82 @@ var SkyTreeConfig = types.SkyTreeConfig{
 // Skyline computes the skyline from a static dataset using the specified algorithm.
 // If algo is empty, defaults to "bnl".
 func Skyline(points []types.Point, _ []string, prefs types.Preference, algo string) ([]types.Point, error) {
+	return SkylineOf(points, prefs, algo)
+}
+
+// SkylineOf computes the skyline of points with any supported coordinate type, such as int32 or float32.
+// The global algorithm configs apply; their Epsilon is truncated for integer coordinates.
+// If algo is empty, defaults to "bnl".
+func SkylineOf[T types.Number](points []types.PointOf[T], prefs types.Preference, algo string) ([]types.PointOf[T], error) {
+	return runSkyline(points, prefs, algo, algorithms.BNLConfig{}, DNCConfig, SkyTreeConfig)
+}
+
+// ConstrainedSkyline computes the skyline over the points satisfying per-dimension ranges, e.g.
+// price between 200 and 800. The filter is fused into the algorithms, so points outside the ranges
+// never prune points inside them and no filtered copy of the dataset is made.
+// If algo is empty, defaults to "bnl".
+func ConstrainedSkyline(points []types.Point, _ []string, prefs types.Preference, constraints types.Constraints, algo string) ([]types.Point, error) {
+	dnc, skytree := DNCConfig, SkyTreeConfig
+	dnc.Constraints, skytree.Constraints = constraints, constraints
+	return runSkyline(points, prefs, algo, algorithms.BNLConfig{Constraints: constraints}, dnc, skytree)
+}
+
+// runSkyline dispatches to the selected algorithm with explicit configs.
+func runSkyline[T types.Number](points []types.PointOf[T], prefs types.Preference, algo string, bnl types.BNLConfig, dnc types.DNCConfig, skytree types.SkyTreeConfig) ([]types.PointOf[T], error) {
 	if algo == "" {
 		algo = "bnl"
 	}
 
-	var result []types.Point
+	var result []types.PointOf[T]
 	switch algo {
 	case "bnl":
-		result = algorithms.BlockNestedLoop(points, prefs)
+		result = algorithms.BNL(points, prefs, bnl)
+	case "sfs":
+		result = algorithms.SFS(points, prefs, bnl)
 	case "dnc":
-		result = algorithms.DivideAndConquer(points, prefs, &DNCConfig)
+		result = algorithms.DivideAndConquer(points, prefs, &dnc)
 	case "skytree":
-		result = algorithms.SkyTree(points, prefs, SkyTreeConfig)
+		result = algorithms.SkyTree(points, prefs, skytree)
 	default:
 		return nil, fmt.Errorf("unknown algorithm: %s", algo)
 	}
 	return result, nil
 }
+
+// requireNumeric rejects categorical dimensions for queries that need a numeric value, such as a
+// distance or a weight, in every dimension; reason completes "dimension d is categorical and ...".
+func requireNumeric(prefs types.Preference, reason string) error {
+	for dim, order := range prefs {
+		if order.PartialOrder() != nil {
+			return fmt.Errorf("dimension %d is categorical and %s", dim, reason)
+		}
+	}
+	return nil
+}
+
+// SkylineMatrix computes the skyline of a contiguous matrix using the specified algorithm.
+// It returns the indices of the skyline rows; use Select or Row on the matrix to read them.
+// If algo is empty, defaults to "bnl".
+func SkylineMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, algo string) ([]int, error) {
+	return runSkylineMatrix(m, prefs, algo)
+}
+
+// runSkylineMatrix dispatches a matrix to the selected index-based algorithm.
+func runSkylineMatrix[T types.Number](m types.MatrixOf[T], prefs types.Preference, algo string) ([]int, error) {
+	if algo == "" {
+		algo = "bnl"
+	}
+
+	switch algo {
+	case "bnl":
+		return algorithms.BNLMatrix(m, prefs, algorithms.BNLConfig{}), nil
+	case "sfs":
+		return algorithms.SFSMatrix(m, prefs, algorithms.BNLConfig{}), nil
+	case "dnc":
+		return algorithms.DivideAndConquerMatrix(m, prefs, &DNCConfig), nil
+	case "skytree":
+		return algorithms.SkyTreeMatrix(m, prefs, SkyTreeConfig), nil
+	default:
+		return nil, fmt.Errorf("unknown algorithm: %s", algo)
+	}
+}
@@ -0,0 +1,24 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestSkylineCategorical(t *testing.T) {
+	// airline: 0 is preferred over 1 and 2, which are incomparable
+	po, err := NewPartialOrder(3, [][2]int{{0, 1}, {0, 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	prefs := Preference{Min, Categorical(po)}
+	points := []Point{{300, 0}, {300, 1}, {250, 1}, {250, 2}, {280, 2}}
+	for _, algo := range []string{"bnl", "sfs", "dnc", "skytree"} {
+		result, err := Skyline(points, nil, prefs, algo)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", algo, err)
+		}
+		if len(result) != 3 {
+			t.Errorf("%s: expected {300, 0}, {250, 1} and {250, 2}, got %v", algo, result)
+		}
+	}
+}
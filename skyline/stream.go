@@ -0,0 +1,127 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/utilities"
+)
+
+// Stream maintains the skyline of a sliding window over a stream of timestamped points.
+// Points expire by count, by time, or both, as set by the StreamWindow.
+type Stream interface {
+	// Push adds a point that arrived at timestamp, expiring points that fell out of the window.
+	// Timestamps must not decrease; with a count-only window they may all be zero.
+	Push(p Point, timestamp int64) error
+	// Advance expires points by time without adding a new one, e.g. on a clock tick.
+	Advance(timestamp int64) error
+	// Skyline returns the skyline of the live points, oldest first.
+	Skyline() []Point
+	// Buffered returns the number of live points that can still reach the skyline.
+	Buffered() int
+}
+
+// streamEntry is a buffered point with the number of older buffered points dominating it.
+type streamEntry struct {
+	point      Point
+	seq        int64
+	timestamp  int64
+	dominators int
+}
+
+// internal stream struct, all fields private
+type stream struct {
+	prefs  Preference
+	window StreamWindow
+	seq    int64
+	latest int64
+
+	// buffer holds, oldest first, the live points not dominated by a newer live point. A point
+	// dominated by a newer one expires first, so it can never reach the skyline and is dropped.
+	buffer []streamEntry
+}
+
+// NewStream creates a Stream over a sliding window. At least one of window.Count and
+// window.Duration must be positive.
+//
+// Only points not dominated by a newer point are buffered, each with a count of the older buffered
+// points dominating it. A point is in the skyline exactly when its count is zero, and the count
+// can only drop when the oldest point expires, so neither arrivals nor expiries rescan the window.
+func NewStream(prefs Preference, window StreamWindow) (Stream, error) {
+	if window.Count < 0 || window.Duration < 0 || (window.Count == 0 && window.Duration == 0) {
+		return nil, fmt.Errorf("invalid stream window: count %d, duration %d", window.Count, window.Duration)
+	}
+	return &stream{prefs: prefs, window: window}, nil
+}
+
+// Push adds a point and updates the buffer incrementally.
+func (s *stream) Push(p Point, timestamp int64) error {
+	if err := s.Advance(timestamp); err != nil {
+		return err
+	}
+	s.seq++
+	s.expire()
+
+	// Drop buffered points that p dominates and count the ones that dominate p.
+	// Whatever a dropped point dominated, p dominates too, so surviving counts stay exact.
+	kept := s.buffer[:0]
+	dominators := 0
+	for _, e := range s.buffer {
+		if utilities.DominatesEpsilon(p, e.point, s.prefs, 0) {
+			continue
+		}
+		if utilities.DominatesEpsilon(e.point, p, s.prefs, 0) {
+			dominators++
+		}
+		kept = append(kept, e)
+	}
+	clear(s.buffer[len(kept):])
+	s.buffer = append(kept, streamEntry{point: p, seq: s.seq, timestamp: timestamp, dominators: dominators})
+	return nil
+}
+
+// Advance moves the clock forward and expires points by time.
+func (s *stream) Advance(timestamp int64) error {
+	if timestamp < s.latest {
+		return fmt.Errorf("timestamp %d is older than %d", timestamp, s.latest)
+	}
+	s.latest = timestamp
+	s.expire()
+	return nil
+}
+
+// expire removes points that fell out of the window from the front of the buffer.
+func (s *stream) expire() {
+	for len(s.buffer) > 0 && s.expired(s.buffer[0]) {
+		oldest := s.buffer[0]
+		s.buffer = s.buffer[1:]
+		for i := range s.buffer {
+			if utilities.DominatesEpsilon(oldest.point, s.buffer[i].point, s.prefs, 0) {
+				s.buffer[i].dominators--
+			}
+		}
+	}
+}
+
+// expired reports whether e is outside the window, counting the point about to be pushed.
+func (s *stream) expired(e streamEntry) bool {
+	if s.window.Count > 0 && s.seq-e.seq >= int64(s.window.Count) {
+		return true
+	}
+	return s.window.Duration > 0 && s.latest-e.timestamp >= s.window.Duration
+}
+
+// Skyline returns the current skyline set.
+func (s *stream) Skyline() []Point {
+	var result []Point
+	for _, e := range s.buffer {
+		if e.dominators == 0 {
+			result = append(result, e.point)
+		}
+	}
+	return result
+}
+
+// Buffered returns the number of buffered points.
+func (s *stream) Buffered() int {
+	return len(s.buffer)
+}
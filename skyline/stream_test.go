@@ -0,0 +1,82 @@
+package skyline
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func sameSkyline(a, b []Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, p := range a {
+		found := false
+		for _, q := range b {
+			if equalPoint(p, q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStreamCountWindow(t *testing.T) {
+	prefs := Preference{Min, Max}
+	s, err := NewStream(prefs, StreamWindow{Count: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	var all []Point
+	for i := 0; i < 1000; i++ {
+		p := Point{float64(rng.Intn(100)), float64(rng.Intn(100))}
+		all = append(all, p)
+		if err := s.Push(p, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		live := all[max(0, len(all)-50):]
+		expected, _ := Skyline(live, nil, prefs, "bnl")
+		if !sameSkyline(s.Skyline(), expected) {
+			t.Fatalf("step %d: expected %v, got %v", i, expected, s.Skyline())
+		}
+		if s.Buffered() > len(live) {
+			t.Fatalf("step %d: buffered %d points of a %d-point window", i, s.Buffered(), len(live))
+		}
+	}
+}
+
+func TestStreamTimeWindow(t *testing.T) {
+	prefs := Preference{Min, Min}
+	s, _ := NewStream(prefs, StreamWindow{Duration: 10})
+	s.Push(Point{1, 1}, 0) // dominates everything until it expires at 10
+	s.Push(Point{2, 5}, 3)
+	s.Push(Point{5, 2}, 4)
+	s.Push(Point{3, 3}, 6)
+	if sky := s.Skyline(); len(sky) != 1 || !equalPoint(sky[0], Point{1, 1}) {
+		t.Errorf("expected only {1, 1}, got %v", sky)
+	}
+	if err := s.Advance(10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sky := s.Skyline(); !sameSkyline(sky, []Point{{2, 5}, {5, 2}, {3, 3}}) {
+		t.Errorf("expected the remaining three points, got %v", sky)
+	}
+	s.Push(Point{4, 4}, 11) // dominated by the older {3, 3}, buffered until it outlives it
+	if s.Buffered() != 4 || len(s.Skyline()) != 3 {
+		t.Errorf("expected 4 buffered and 3 skyline points, got %d and %v", s.Buffered(), s.Skyline())
+	}
+	s.Push(Point{2, 2}, 12) // newer and dominating every buffered point, which can never return
+	if s.Buffered() != 1 {
+		t.Errorf("expected 1 buffered point, got %d", s.Buffered())
+	}
+	if err := s.Push(Point{0, 0}, 11); err == nil {
+		t.Errorf("expected an error for a decreasing timestamp")
+	}
+	if _, err := NewStream(prefs, StreamWindow{}); err == nil {
+		t.Errorf("expected an error for an empty window")
+	}
+}
@@ -0,0 +1,32 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// TopK ranks points by a weighted sum of their coordinates and returns the best k, highest
+// utility first. Each non-ignored dimension is normalized to [0, 1] over points, with 1 the best
+// value according to prefs, and multiplied by the matching weight.
+// weights must have one non-negative entry per dimension of prefs.
+// Only k-skyband points are scored, since no other point can make the top k. Categorical
+// dimensions have no value to weight and are rejected.
+func TopK(points []Point, prefs Preference, weights []float64, k int) ([]Point, error) {
+	if err := validateWeights(prefs, weights); err != nil {
+		return nil, err
+	}
+	return algorithms.TopK(points, prefs, weights, k), nil
+}
+
+func validateWeights(prefs Preference, weights []float64) error {
+	if len(weights) != len(prefs) {
+		return fmt.Errorf("expected %d weights, got %d", len(prefs), len(weights))
+	}
+	for dim, w := range weights {
+		if w < 0 {
+			return fmt.Errorf("negative weight %v for dimension %d", w, dim)
+		}
+	}
+	return requireNumeric(prefs, "cannot be weighted")
+}
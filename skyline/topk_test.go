@@ -0,0 +1,48 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	// price (Min), rating (Max)
+	points := []Point{{100, 4}, {200, 5}, {150, 3}, {300, 4}, {120, 2}}
+	prefs := Preference{Min, Max}
+
+	top, err := TopK(points, prefs, []float64{0, 1}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(top) != 2 || !equalPoint(top[0], Point{200, 5}) || !equalPoint(top[1], Point{100, 4}) {
+		t.Errorf("expected the two best-rated points, cheapest first on ties, got %v", top)
+	}
+
+	// Equal weights: {100, 4} scores 1 + 2/3, beating {200, 5} at 0.5 + 1
+	top, _ = TopK(points, prefs, []float64{1, 1}, 1)
+	if len(top) != 1 || !equalPoint(top[0], Point{100, 4}) {
+		t.Errorf("expected {100, 4}, got %v", top)
+	}
+
+	if _, err := TopK(points, prefs, []float64{1, -1}, 1); err == nil {
+		t.Errorf("expected an error for a negative weight")
+	}
+}
+
+func TestTopKCategorical(t *testing.T) {
+	po, err := NewPartialOrder(2, [][2]int{{0, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	points := []Point{{100, 0}, {80, 1}}
+	prefs := Preference{Min, Categorical(po)}
+	if _, err := TopK(points, prefs, []float64{1, 1}, 1); err == nil {
+		t.Errorf("expected TopK to reject a categorical dimension")
+	}
+	e, err := DynamicSkyline(points, nil, prefs, "bnl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := e.TopK([]float64{1, 1}, 1); err == nil {
+		t.Errorf("expected TopKEngine.TopK to reject a categorical dimension")
+	}
+}
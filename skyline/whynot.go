@@ -0,0 +1,22 @@
+package skyline
+
+import (
+	"fmt"
+
+	"github.com/gkoos/skyline/v2/internal/algorithms"
+)
+
+// WhyNot explains why x is not in the skyline of points: it returns the skyline points that
+// dominate x and, for each dimension, the change to x in that dimension alone (e.g. a price cut
+// of 12) past which x would be on the skyline. x does not need to be one of points.
+func WhyNot(points []Point, prefs Preference, x Point) (WhyNotAnswer, error) {
+	if len(x) != len(prefs) {
+		return WhyNotAnswer{}, fmt.Errorf("point has %d dimensions, expected %d", len(x), len(prefs))
+	}
+	for i, p := range points {
+		if len(p) != len(prefs) {
+			return WhyNotAnswer{}, fmt.Errorf("point %d has %d dimensions, expected %d", i, len(p), len(prefs))
+		}
+	}
+	return algorithms.WhyNot(points, prefs, x), nil
+}
@@ -0,0 +1,37 @@
+package skyline
+
+import (
+	"testing"
+)
+
+func TestWhyNot(t *testing.T) {
+	// price (Min), battery hours (Max)
+	phones := []Point{{500, 10}, {400, 8}, {700, 14}, {550, 9}}
+	prefs := Preference{Min, Max}
+
+	answer, err := WhyNot(phones, prefs, Point{550, 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answer.Dominators) != 1 || !equalPoint(answer.Dominators[0], Point{500, 10}) {
+		t.Errorf("expected {500, 10} as the only dominator, got %v", answer.Dominators)
+	}
+	// A price cut past 50 or more than one extra hour of battery
+	if !equalPoint(answer.Changes, Point{-50, 1}) {
+		t.Errorf("expected changes [-50 1], got %v", answer.Changes)
+	}
+
+	answer, err = WhyNot(phones, prefs, Point{400, 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answer.Dominators) != 0 || !equalPoint(answer.Changes, Point{0, 0}) {
+		t.Errorf("expected a skyline point to need no change, got %+v", answer)
+	}
+}
+
+func TestWhyNotDimensionMismatch(t *testing.T) {
+	if _, err := WhyNot([]Point{{1, 2}}, Preference{Min, Min}, Point{1}); err == nil {
+		t.Error("expected an error for a point with the wrong number of dimensions")
+	}
+}
@@ -0,0 +1,76 @@
+package types
+
+// MatrixOf stores points with coordinates of type T contiguously in row-major order.
+// Row i occupies Data[i*Stride : i*Stride+Cols]; Stride may exceed Cols to allow padded rows.
+type MatrixOf[T Number] struct {
+	Data   []T
+	Rows   int
+	Cols   int
+	Stride int
+}
+
+// Matrix is the float64 instantiation of MatrixOf.
+type Matrix = MatrixOf[float64]
+
+// NewMatrix allocates a zeroed rows x cols float64 matrix with Stride equal to cols.
+func NewMatrix(rows, cols int) Matrix {
+	return NewMatrixOf[float64](rows, cols)
+}
+
+// NewMatrixOf allocates a zeroed rows x cols matrix with Stride equal to cols.
+func NewMatrixOf[T Number](rows, cols int) MatrixOf[T] {
+	return MatrixOf[T]{
+		Data:   make([]T, rows*cols),
+		Rows:   rows,
+		Cols:   cols,
+		Stride: cols,
+	}
+}
+
+// MatrixFromDataset copies a dataset into a single contiguous matrix.
+// All points are expected to have the same number of dimensions as the first one.
+func MatrixFromDataset(data Dataset) Matrix {
+	return MatrixFromPoints(data)
+}
+
+// MatrixFromPoints copies points of any coordinate type into a single contiguous matrix.
+// All points are expected to have the same number of dimensions as the first one.
+func MatrixFromPoints[T Number](data []PointOf[T]) MatrixOf[T] {
+	if len(data) == 0 {
+		return MatrixOf[T]{}
+	}
+	m := NewMatrixOf[T](len(data), len(data[0]))
+	for i, p := range data {
+		copy(m.Row(i), p)
+	}
+	return m
+}
+
+// Row returns row i as a point that shares storage with the matrix.
+func (m MatrixOf[T]) Row(i int) PointOf[T] {
+	off := i * m.Stride
+	return m.Data[off : off+m.Cols : off+m.Cols]
+}
+
+// At returns the value of row i in dimension j.
+func (m MatrixOf[T]) At(i, j int) T {
+	return m.Data[i*m.Stride+j]
+}
+
+// Dataset copies the matrix rows into independent points.
+func (m MatrixOf[T]) Dataset() []PointOf[T] {
+	data := make([]PointOf[T], m.Rows)
+	for i := range data {
+		data[i] = append(PointOf[T](nil), m.Row(i)...)
+	}
+	return data
+}
+
+// Select copies the given rows into a new compact matrix, in the given order.
+func (m MatrixOf[T]) Select(rows []int) MatrixOf[T] {
+	out := NewMatrixOf[T](len(rows), m.Cols)
+	for i, r := range rows {
+		copy(out.Row(i), m.Row(r))
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+)
+
+// PartialOrder ranks the category codes 0..n-1 of a categorical dimension by a preference DAG,
+// e.g. {A > B, A > C} where B and C are incomparable. Reachability is precomputed, so comparing
+// two codes is a single bit test.
+type PartialOrder struct {
+	n      int
+	better [][]uint64 // better[a] has bit b set if a is preferred over b, directly or transitively
+	depth  []int      // length of the longest preference chain above each code
+}
+
+// NewPartialOrder builds the partial order over categories codes in which edge {a, b} means that
+// a is preferred over b. Preferences are transitive. It fails on codes out of range and on cycles.
+func NewPartialOrder(categories int, edges [][2]int) (*PartialOrder, error) {
+	succ := make([][]int, categories)
+	indegree := make([]int, categories)
+	for _, e := range edges {
+		a, b := e[0], e[1]
+		if a < 0 || a >= categories || b < 0 || b >= categories {
+			return nil, fmt.Errorf("preference %d > %d: category out of range [0, %d)", a, b, categories)
+		}
+		succ[a] = append(succ[a], b)
+		indegree[b]++
+	}
+
+	// Kahn's algorithm gives a topological order and the depth of every code
+	po := &PartialOrder{n: categories, better: make([][]uint64, categories), depth: make([]int, categories)}
+	var topo, queue []int
+	for c := 0; c < categories; c++ {
+		po.better[c] = make([]uint64, (categories+63)/64)
+		if indegree[c] == 0 {
+			queue = append(queue, c)
+		}
+	}
+	for len(queue) > 0 {
+		a := queue[0]
+		queue = queue[1:]
+		topo = append(topo, a)
+		for _, b := range succ[a] {
+			po.depth[b] = max(po.depth[b], po.depth[a]+1)
+			if indegree[b]--; indegree[b] == 0 {
+				queue = append(queue, b)
+			}
+		}
+	}
+	if len(topo) != categories {
+		return nil, fmt.Errorf("preferences contain a cycle")
+	}
+
+	// In reverse topological order every successor's reachability is already complete
+	for i := len(topo) - 1; i >= 0; i-- {
+		a := topo[i]
+		for _, b := range succ[a] {
+			po.better[a][b/64] |= 1 << (b % 64)
+			for w, bits := range po.better[b] {
+				po.better[a][w] |= bits
+			}
+		}
+	}
+	return po, nil
+}
+
+// Prefers returns true if category a is preferred over category b.
+// Codes outside the order are incomparable to every other code.
+func (po *PartialOrder) Prefers(a, b int) bool {
+	if a < 0 || a >= po.n || b < 0 || b >= po.n {
+		return false
+	}
+	return po.better[a][b/64]&(1<<(b%64)) != 0
+}
+
+// Depth returns the length of the longest chain of categories preferred over c, which is 0 for the
+// most preferred categories. If a is preferred over b then Depth(a) < Depth(b), so Depth is a
+// linear extension usable for presorting.
+func (po *PartialOrder) Depth(c int) int {
+	if c < 0 || c >= po.n {
+		return 0
+	}
+	return po.depth[c]
+}
+
+// Categories returns the number of category codes in the order.
+func (po *PartialOrder) Categories() int {
+	return po.n
+}
+
+// Categorical returns the Order for a dimension whose values are category codes ranked by po.
+// The Order can be used in any Preference next to Min, Max and Ignore, and refers to po directly.
+func Categorical(po *PartialOrder) Order {
+	return Order{kind: categoricalKind, po: po}
+}
+
+// PartialOrder returns the PartialOrder behind a categorical Order, or nil for Min, Max and Ignore.
+func (o Order) PartialOrder() *PartialOrder {
+	return o.po
+}
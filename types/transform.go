@@ -0,0 +1,47 @@
+package types
+
+import "math"
+
+// Transform maps a point to the coordinates used for dominance checks, one dimension at a time.
+// Algorithms evaluate it on the fly, so queries run in the transformed space without
+// materializing a transformed copy of the dataset. Dims is the number of transformed dimensions,
+// which a Preference over the transformed space must match.
+type Transform interface {
+	Value(p Point, dim int) float64
+	Dims() int
+}
+
+// QueryTransform replaces each coordinate by its absolute distance to Query, the space in
+// which the dynamic skyline relative to Query is computed.
+type QueryTransform struct {
+	Query Point
+}
+
+// Value returns |p[dim] - Query[dim]|.
+func (t QueryTransform) Value(p Point, dim int) float64 {
+	return math.Abs(p[dim] - t.Query[dim])
+}
+
+// Dims returns len(Query).
+func (t QueryTransform) Dims() int {
+	return len(t.Query)
+}
+
+// MetricTransform replaces a point by its distances to Queries under Metric: dimension i is
+// Metric(p, Queries[i]). It generalizes QueryTransform to any metric, such as great-circle
+// distance on latitude/longitude points; use Min for every transformed dimension. It has one
+// dimension per query and no other attributes.
+type MetricTransform struct {
+	Queries []Point
+	Metric  func(a, b Point) float64
+}
+
+// Value returns Metric(p, Queries[dim]).
+func (t MetricTransform) Value(p Point, dim int) float64 {
+	return t.Metric(p, t.Queries[dim])
+}
+
+// Dims returns len(Queries).
+func (t MetricTransform) Dims() int {
+	return len(t.Queries)
+}
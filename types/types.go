@@ -1,35 +1,151 @@
 package types
 
-type Point []float64
+import "math"
+
+// Number is the set of coordinate types supported by the generic dominance routine and algorithms.
+type Number interface {
+	~int | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// PointOf is a point with coordinates of type T.
+type PointOf[T Number] []T
+
+// Point is the float64 point used throughout the non-generic API.
+type Point = PointOf[float64]
 
 type Dataset []Point
 
 // Preference specifies per-dimension optimization (Min or Max)
 type Preference []Order
 
-type Order int
+// Order specifies how a dimension is compared: Min, Max, Ignore, or a categorical order built
+// with Categorical. The zero Order is Min. Orders are comparable, and two categorical orders are
+// equal if they use the same PartialOrder. Because Order is a struct, Min, Max and Ignore are
+// variables rather than constants; they must never be reassigned.
+type Order struct {
+	kind orderKind
+	po   *PartialOrder
+}
+
+type orderKind uint8
 
 const (
-	Min Order = iota
-	Max
-	Ignore // Skip this dimension in dominance comparisons
+	minKind orderKind = iota
+	maxKind
+	ignoreKind
+	categoricalKind
+)
+
+var (
+	Min    = Order{kind: minKind}
+	Max    = Order{kind: maxKind}
+	Ignore = Order{kind: ignoreKind} // Skip this dimension in dominance comparisons
 )
 
+// Range is a closed interval of allowed values for one dimension.
+// Use math.Inf for an open side, or Unbounded for no restriction.
+type Range struct {
+	Min, Max float64
+}
+
+// Unbounded is the Range that admits every value.
+var Unbounded = Range{Min: math.Inf(-1), Max: math.Inf(1)}
+
+// Constraints restricts each dimension to a Range, indexed like Preference.
+// Dimensions beyond the end of Constraints are unconstrained; a nil Constraints admits every point.
+type Constraints []Range
+
+// Subspace selects a subset of dimensions as a bitmask: bit i set means dimension i is included.
+type Subspace uint64
+
+// SubspaceOf returns the Subspace containing the given dimensions.
+func SubspaceOf(dims ...int) Subspace {
+	var s Subspace
+	for _, d := range dims {
+		s |= 1 << d
+	}
+	return s
+}
+
+// WeightRatio bounds the ratio between the weight of one dimension and the weight of a reference
+// dimension in a linear scoring function. Both bounds must be finite and 0 <= Low <= High.
+type WeightRatio struct {
+	Low, High float64
+}
+
+// UncertainPoint is an object whose true position is one of several instances, e.g. repeated
+// noisy measurements. Probs[i] is the probability of Instances[i] and the probabilities sum to at
+// most 1; a nil Probs makes all instances equally likely.
+type UncertainPoint struct {
+	Instances []Point
+	Probs     []float64
+}
+
+// Prob returns the probability of instance i.
+func (u UncertainPoint) Prob(i int) float64 {
+	if u.Probs == nil {
+		return 1 / float64(len(u.Instances))
+	}
+	return u.Probs[i]
+}
+
+// Aggregate combines the values of a group of points in one dimension.
+type Aggregate int
+
+const (
+	SumAggregate Aggregate = iota
+	MinAggregate
+	MaxAggregate
+)
+
+// Group is a set of points with their per-dimension aggregate.
+type Group struct {
+	Points    []Point
+	Aggregate Point
+}
+
+// WhyNotAnswer explains why a point is not in the skyline. Dominators are the skyline points that
+// dominate it. Changes[d] is the signed change to dimension d alone that brings the point to the
+// boundary of the skyline: moving it strictly beyond that value puts it on the skyline, and any
+// smaller change does not. Changes is NaN for ignored and categorical dimensions and all zero when
+// the point is already on the skyline.
+type WhyNotAnswer struct {
+	Dominators []Point
+	Changes    Point
+}
+
+// DominanceScore pairs a point with the number of points it dominates.
+type DominanceScore struct {
+	Point Point
+	Count int
+}
+
+// StreamWindow bounds the live points of a streaming skyline. A point expires once Count newer
+// points have arrived, or once the latest timestamp exceeds its own by Duration or more.
+// A zero field disables that bound.
+type StreamWindow struct {
+	Count    int
+	Duration int64
+}
+
 type DNCConfig struct {
-	Threshold int
-	BatchSize int
-	Epsilon   float64 // Relaxed dominance tolerance
+	Threshold   int
+	BatchSize   int
+	Epsilon     float64     // Relaxed dominance tolerance
+	Constraints Constraints // Only points inside these ranges take part in the skyline
 }
 
 type SkyTreeConfig struct {
 	PivotSelector      func(data Dataset, prefs Preference) Point
-	ParallelThreshold  int     // Minimum number of partitions to parallelize
-	MaxRecursionDepth  int     // Maximum allowed recursion depth for SkyTree
-	BNLSwitchThreshold int     // Switch to BNL if len(data) <= this
-	WorkerPoolSize     int     // Number of workers for parallel processing (0 = all available cores)
-	Epsilon            float64 // Relaxed dominance tolerance
+	ParallelThreshold  int         // Minimum number of partitions to parallelize
+	MaxRecursionDepth  int         // Maximum allowed recursion depth for SkyTree
+	BNLSwitchThreshold int         // Switch to BNL if len(data) <= this
+	WorkerPoolSize     int         // Number of workers for parallel processing (0 = all available cores)
+	Epsilon            float64     // Relaxed dominance tolerance
+	Constraints        Constraints // Only points inside these ranges take part in the skyline
 }
 
 type BNLConfig struct {
-	Epsilon float64 // Relaxed dominance tolerance
+	Epsilon     float64     // Relaxed dominance tolerance
+	Constraints Constraints // Only points inside these ranges take part in the skyline
 }